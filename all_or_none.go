@@ -0,0 +1,33 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+)
+
+// AllOrNone is a Statement which requires the end user to set either all or none
+// of the named options/flags e.g. (--user and --password), see cli.ExactlyOneOf.
+func AllOrNone(names ...string) rule {
+	names = constrained("cli.AllOrNone", 2, names)
+	return rule{
+		names: names,
+		text:  fmt.Sprintf("either all or none of %s must be given", hyphenate(names)),
+		violated: func(given map[string]bool) bool {
+			n := count(given, names)
+			return n != 0 && n != len(names)
+		},
+	}
+}
@@ -14,6 +14,11 @@
 
 package cli
 
+// Application is a command line application created by cli.Nested or cli.Simple.
+//
+// Run returns cli.HelpRequested when the end user asked for the usage message,
+// *cli.UsageError when the application was invoked incorrectly, otherwise
+// the error (if any) returned by the executed Implementation.
 type Application interface {
 	Run(args []string) error
 }
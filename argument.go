@@ -17,6 +17,8 @@ package cli
 import (
 	"fmt"
 	"strings"
+
+	"github.com/begopher/cli/internal/api"
 )
 
 // Argument represents a required value which must be given by the client of
@@ -58,12 +60,28 @@ func (a argument) Description() string {
 	return a.description
 }
 
-func (a argument) Extract(namedArgs map[string]string, args []string) []string {
+func (a argument) Arg() string {
+	return a.name
+}
+
+func (a argument) Value() string {
+	return ""
+}
+
+func (a argument) Completer() api.Completer {
+	return nil
+}
+
+func (a argument) Optional() bool {
+	return false
+}
+
+func (a argument) Extract(namedArgs map[string]string, values map[string]any, args []string) ([]string, error) {
 	if len(args) == 0 {
-		return args
+		return args, nil
 	}
 	namedArgs[a.name] = args[0]
-	return args[1:]
+	return args[1:], nil
 }
 func (a argument) String(width int) string {
 	return fmt.Sprintf("  %-[1]*s  %s\n", width, a.name, a.description)
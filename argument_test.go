@@ -0,0 +1,70 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestOptionalArguments(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(), Flags(),
+			Arguments(Argument("SOURCE", "source file"), OptionalArgument("TARGET", "target file", "out.txt"), OptionalArgument("MODE", "file mode", "")),
+			NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		target   string
+		mode     string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool", "in.txt"}, "out.txt", "", Unspecified, "", 0},
+		{[]string{"tool", "in.txt", "a.txt"}, "a.txt", "", Unspecified, "", 0},
+		{[]string{"tool", "in.txt", "a.txt", "644"}, "a.txt", "644", Unspecified, "", 0},
+		{[]string{"tool"}, "", "", MissingArgument, "", 1},
+		{[]string{"tool", "in.txt", "a.txt", "644", "x"}, "", "", UnexpectedValue, "x", 4},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if target, mode := ctx.Argument("TARGET"), ctx.Argument("MODE"); target != test.target || mode != test.mode {
+			t.Errorf("%v: target is %q and mode is %q, want %q and %q", test.args, target, mode, test.target, test.mode)
+		}
+	}
+	var stdout, stderr bytes.Buffer
+	Execute(app(Function(func(Context) error { return nil })), []string{"tool", "--help"}, &stdout, &stderr)
+	if !strings.HasPrefix(stdout.String(), "Usage: tool [--] SOURCE [TARGET] [MODE]") {
+		t.Errorf("usage line does not mark optional arguments:\n%s", stdout.String())
+	}
+	if !strings.Contains(stdout.String(), "target file (out.txt)") {
+		t.Errorf("usage does not print the default value:\n%s", stdout.String())
+	}
+}
+
+func TestRequiredArgumentCannotFollowOptionalArgument(t *testing.T) {
+	defer func() {
+		msg, _ := recover().(string)
+		if want := "cli.Arguments: required argument (MODE) cannot follow optional argument (TARGET)"; msg != want {
+			t.Errorf("panics with %q, want %q", msg, want)
+		}
+	}()
+	Arguments(Argument("SOURCE", "source file"), OptionalArgument("TARGET", "target file", "out.txt"), Argument("MODE", "file mode"))
+}
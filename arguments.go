@@ -41,7 +41,17 @@ func Arguments(args ...api.Argument) arguments {
 	}
 	namespace := namespace()
 	var width int
+	var optional string
 	for _, arg := range args {
+		if arg == nil {
+			panic("cli.Arguments: nil value is not allowed")
+		}
+		if arg.Optional() {
+			optional = arg.Name()
+		} else if optional != "" {
+			msg := fmt.Sprintf("cli.Arguments: required argument (%s) cannot follow optional argument (%s)", arg.Name(), optional)
+			panic(msg)
+		}
 		if err := namespace.Add(arg.Name()); err != nil {
 			msg := fmt.Sprintf("cli.Arguments: duplicated argument name (%s)", arg.Name())
 			panic(msg)
@@ -79,29 +89,39 @@ func (a arguments) Names() []string {
 	return names
 }
 
+func (a arguments) Arg() string {
+	args := make([]string, len(a.args))
+	for i, arg := range a.args {
+		args[i] = arg.Arg()
+	}
+	return strings.Join(args, " ")
+}
+
+func (a arguments) List() []api.Argument {
+	return a.args
+}
+
 func (a arguments) Count() int {
 	return len(a.args)
 }
 
-func (a arguments) Extract(namedArgs map[string]string, args []string) ([]string, error) {
-	if len(a.args) > len(args) {
-		missing := len(a.args) - len(args)
-		start := len(a.args) - missing
-		if missing == 1 {
-			arg := a.args[start]
-			err := fmt.Errorf("Error: missing value for (%s) argument", arg.Name())
-			return args, err
-		}
-		remains := a.args[start:]
-		names := make([]string, len(remains))
-		for i, arg := range remains {
-			names[i] = arg.Name()
+func (a arguments) Extract(namedArgs map[string]string, values map[string]any, args []string) ([]string, error) {
+	var names []string
+	for i := len(args); i < len(a.args); i++ {
+		if !a.args[i].Optional() {
+			names = append(names, a.args[i].Name())
 		}
-		err := fmt.Errorf("Error: missing values for (%s) arguments", strings.Join(names, ", "))
+	}
+	if len(names) > 0 {
+		err := parseError(MissingArgument, nil)
+		err.Names = names
 		return args, err
 	}
 	for _, arg := range a.args {
-		args = arg.Extract(namedArgs, args)
+		var err error
+		if args, err = arg.Extract(namedArgs, values, args); err != nil {
+			return args, err
+		}
 	}
 	return args, nil
 }
@@ -0,0 +1,32 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+)
+
+// AtMostOneOf is a Statement which prevents the end user from setting more than
+// one of the named options/flags, see cli.ExactlyOneOf.
+func AtMostOneOf(names ...string) rule {
+	names = constrained("cli.AtMostOneOf", 2, names)
+	return rule{
+		names: names,
+		text:  fmt.Sprintf("at most one of %s can be given", hyphenate(names)),
+		violated: func(given map[string]bool) bool {
+			return count(given, names) > 1
+		},
+	}
+}
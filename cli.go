@@ -18,17 +18,31 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/begopher/cli/internal/api"
 )
 
 func Error(c Context, err error) error {
 	if err != nil {
-		msg := fmt.Sprintf("Error: %s", err)
-		return c.Usage(msg)
+		return c.Usage(summary(err))
 	}
 	return nil
 }
 
+// summary renders err as a line of the usage message.
+func summary(err error) string {
+	return fmt.Sprintf("Error: %s", err)
+}
+
 func removeAbsolutePath(name string) string {
 	names := strings.Split(name, string(os.PathSeparator))
 	return names[len(names)-1]
 }
+
+// posixlyCorrect reports whether POSIXLY_CORRECT environment variable is set,
+// which makes all commands stop parsing options and flags at the first
+// positional argument.
+func posixlyCorrect(sources api.Sources) bool {
+	_, ok := sources.Env("POSIXLY_CORRECT")
+	return ok
+}
@@ -0,0 +1,121 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	goflag "flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = goflag.Bool("update", false, "update the golden files in testdata")
+
+// golden compares got against the content of testdata/name, which is rewritten
+// when the tests run with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s does not match, run go test -update and review the diff\ngot:\n%s", path, got)
+	}
+}
+
+// fixture returns an application which covers groups, parents, variadic arguments,
+// enum options, negatable flags and completed options and arguments.
+func fixture() Application {
+	branches := func(ctx Context, partial string) ([]string, Directive) {
+		return []string{"main\tdefault branch", "develop"}, CompleteNoFiles
+	}
+	remotes := func(ctx Context, partial string) ([]string, Directive) {
+		return []string{"origin", "upstream"}, CompleteNoFiles
+	}
+	nothing := Function(func(Context) error { return nil })
+	deploy := Command("deploy", "Deploy the given services.", Statements(),
+		Options(
+			EnumOption("e", "env", "target environment", []string{"dev", "prod"}, "dev"),
+			Completed(Option("b", "branch", "branch to deploy", "main"), branches),
+		),
+		Flags(NegatableFlag("", "push", "push the images", true)),
+		Arguments(),
+		Variadic("SERVICE", "services to deploy"),
+		nothing)
+	status := Command("status", "Show the status of the services.", Statements(),
+		Options(), Flags(Flag("s", "short", "short format")), Arguments(), NoVariadic(), nothing)
+	add := Command("add", "Add a remote.", Statements(),
+		Options(), Flags(), Arguments(Argument("NAME", "name of the remote"), Argument("URL", "address of the remote")), NoVariadic(), nothing)
+	remove := Command("remove", "Remove a remote.", Statements(),
+		Options(), Flags(), Arguments(CompletedArgument(Argument("NAME", "name of the remote"), remotes)), NoVariadic(), nothing)
+	remote := Parent("remote", "Manage remotes.", Statements(), Options(), Flags(), add, remove)
+	return Nested("tool", "A tool to deploy services.", Statements(),
+		Options(Option("c", "config", "configuration file", "tool.json")),
+		Flags(CountFlag("v", "verbose", "verbose output")),
+		Group("Services", deploy, status),
+		Group("Settings", remote))
+}
+
+// invoke runs the application built by app with args, where env holds all
+// environment variables. It returns the context given to the implementation
+// (nil when it is not executed) and the ParseError (if any).
+func invoke(t *testing.T, app func(Implementation) Application, env map[string]string, args ...string) (Context, *ParseError) {
+	t.Helper()
+	var executed Context
+	implementation := Function(func(ctx Context) error {
+		executed = ctx
+		return nil
+	})
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+	err := Environment(app(implementation), lookup).Run(args)
+	var parse *ParseError
+	if err != nil && !errors.As(err, &parse) {
+		t.Fatalf("%v: unexpected error: %v", args, err)
+	}
+	return executed, parse
+}
+
+// failed reports whether parse is the expected error, where Unspecified kind
+// means no error is expected.
+func failed(t *testing.T, args []string, parse *ParseError, kind ErrorKind, token string, position int) bool {
+	t.Helper()
+	if kind == Unspecified {
+		if parse != nil {
+			t.Errorf("%v: unexpected error: %v", args, parse)
+			return true
+		}
+		return false
+	}
+	if parse == nil {
+		t.Errorf("%v: expected %s error", args, kind)
+		return true
+	}
+	if parse.Kind != kind || parse.Token != token || parse.Position != position {
+		t.Errorf("%v: got %s (%q at %d), want %s (%q at %d)", args, parse.Kind, parse.Token, parse.Position, kind, token, position)
+	}
+	return true
+}
@@ -15,6 +15,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"github.com/begopher/cli/internal/api"
 	"strings"
@@ -50,6 +51,7 @@ func Command(name string, description string, statement Statement, opts api.Opti
 	if implementation == nil {
 		panic("cli.Command: implementation cannot be nil")
 	}
+	verify("cli.Command", statement, opts, flgs)
 	namespace := namespace()
 	namespace.AddAll(opts.Names())
 	if err := namespace.AddAll(flgs.Names()); err != nil {
@@ -72,6 +74,19 @@ func Command(name string, description string, statement Statement, opts api.Opti
 	}
 }
 
+// Strict returns a copy of the command which stops parsing options and flags at
+// the first positional argument (POSIX style), everything after it is treated as
+// arguments/variadic values even when it starts with a hyphen. It is meant for
+// commands wrapping other programs e.g. (tool exec -v docker run -it image).
+//
+// By default options and flags may appear anywhere among positional arguments until
+// a literal double hyphens (--) (GNU style). Setting POSIXLY_CORRECT environment
+// variable makes all commands strict.
+func (c command) Strict() command {
+	c.strict = true
+	return c
+}
+
 type command struct {
 	name           string
 	description    string
@@ -82,6 +97,7 @@ type command struct {
 	arguments      api.Arguments
 	variadic       api.Variadic
 	namespace      api.Namespace
+	strict         bool
 }
 
 func (c command) Name() string {
@@ -92,7 +108,7 @@ func (c command) Description() string {
 	return c.description
 }
 
-func (c command) Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error) {
+func (c command) Exec(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error) {
 	if len(args) == 0 {
 		return false, nil
 	}
@@ -102,78 +118,131 @@ func (c command) Exec(path []string, options map[string]string, flags map[string
 
 	path = append(path, c.name)
 	fullPath := strings.Join(path, " ")
+	usage := func(summaries ...string) string {
+		return c.usage(fullPath, summaries...)
+	}
 	args = args[1:]
-	args = c.extract(options, flags, args)
-	c.opts.Default(options)
-	c.flags.Default(flags)
+	positionals, offsets, args, err := c.extract(sources, options, flags, values, args)
+	if err != nil {
+		return false, failure(path, err, usage)
+	}
+	if err := c.opts.Resolve(path, sources, options, values); err != nil {
+		return false, failure(path, err, usage)
+	}
+	if err := c.flags.Resolve(path, sources, flags, values); err != nil {
+		return false, failure(path, err, usage)
+	}
+	// constraints count every value which is not a default value
+	set := given(options, flags)
+	c.opts.Default(options, values)
+	c.flags.Default(flags, values)
 	if len(args) > 0 {
 		if args[0] == "--help" { // done
-			return false, fmt.Errorf(c.usage(fullPath))
+			return false, helpRequested(path, c.usage(fullPath))
 		}
 		if args[0] == "--" {
 			// done
-			args = args[1:]
-		} else if strings.HasPrefix(args[0], "-") {
+			for i, value := range args[1:] {
+				positionals = append(positionals, value)
+				offsets = append(offsets, len(args)-1-i)
+			}
+		} else if args[0] != "-" && strings.HasPrefix(args[0], "-") {
 			if c.opts.Has(args[0]) { // done
-				msg := fmt.Sprintf("Error: missing value for %s option (e.g. %[1]s value)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(MissingOptionValue, args), usage)
 			}
 			if c.opts.Count() > 0 && c.flags.Count() > 0 { // done
-				msg := fmt.Sprintf("Error: unknown option or flag (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(UnknownOptionOrFlag, args), usage)
 			}
 			if c.opts.Count() > 0 { // done
-				msg := fmt.Sprintf("Error: unknown option (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(UnknownOption, args), usage)
 			}
 			if c.flags.Count() > 0 { // done
-				msg := fmt.Sprintf("Error: unknown flag (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(UnknownFlag, args), usage)
 			}
 			if c.arguments.Count() > 0 { // done
-				msg := fmt.Sprintf("Error: double hyphens (--) is missing before (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(MissingDoubleDash, args), usage)
 			}
 			if c.variadic.Allowed() { // done
-				msg := fmt.Sprintf("Error: double hyphens (--) is missing before (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+				return false, parseFailure(path, parseError(MissingDoubleDash, args), usage)
 			}
 			// done
-			if len(args) == 1 {
-				msg := fmt.Sprintf("Error: unexpected value (%s)", args[0])
-				return false, fmt.Errorf(c.usage(fullPath, msg))
+			err := parseError(UnexpectedValue, args)
+			err.Values = args
+			return false, parseFailure(path, err, usage)
+		} else { // strict mode
+			for i, value := range args {
+				positionals = append(positionals, value)
+				offsets = append(offsets, len(args)-i)
 			}
-			msg := fmt.Sprintf("Error: unexpected values (%s)", strings.Join(args, ", "))
-			return false, fmt.Errorf(c.usage(fullPath, msg))
 		}
 	} //end if invalid option of flag
+	if err := sources.Checks(); err != nil {
+		return false, err
+	}
+	if err := c.opts.Validate(options, values); err != nil {
+		return false, failure(path, err, usage)
+	}
+	if err := check(c.statement, set); err != nil {
+		return false, failure(path, err, usage)
+	}
 	namedArgs := make(map[string]string, c.arguments.Count())
-	args, err := c.arguments.Extract(namedArgs, args)
+	argValues := make(map[string]any, c.arguments.Count())
+	rest, err := c.arguments.Extract(namedArgs, argValues, positionals)
 	if err != nil {
-		return false, fmt.Errorf(c.usage(fullPath, err.Error()))
+		return false, failure(path, relocate(err, offsets), usage)
 	}
-	variadicArgs, err := c.variadic.Extract(args)
+	variadicArgs, err := c.variadic.Extract(rest)
 	if err != nil {
-		return false, fmt.Errorf(c.usage(fullPath, err.Error()))
+		return false, failure(path, relocate(err, offsets), usage)
 	}
-	usage := func(summaries ...string) error {
-		return fmt.Errorf(c.usage(fullPath, summaries...))
+	report := func(summaries ...string) error {
+		msg := strings.Join(summaries, "\n")
+		return usageError(path, Unspecified, "", errors.New(msg), usage(summaries...))
 	}
-	ctx := context(path, options, flags, namedArgs, variadicArgs, usage)
+	ctx := context(path, sources, options, flags, values, namedArgs, argValues, variadicArgs, report)
 	if err := c.implementation.Exec(ctx); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (c command) extract(options map[string]string, flags map[string]bool, args []string) []string {
-	length := len(args)
-	args = c.opts.Extract(options, args)
-	args = c.flags.Extract(flags, args)
-	if length != len(args) {
-		args = c.extract(options, flags, args)
+// extract consumes options and flags from args, positional arguments found among
+// them are collected along with their offsets (the number of args starting from
+// each positional argument), until a literal double hyphens (--) or an unknown
+// option/flag is found, or until the first positional argument in strict mode.
+// The remaining args are returned as is.
+func (c command) extract(sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (positionals []string, offsets []int, rest []string, err error) {
+	strict := c.strict || posixlyCorrect(sources)
+	for len(args) > 0 {
+		length, first := len(args), args[0]
+		if args, err = c.opts.Extract(options, values, args); err != nil {
+			return positionals, offsets, args, err
+		}
+		if args, err = c.flags.Extract(flags, values, args); err != nil {
+			return positionals, offsets, args, err
+		}
+		if length != len(args) || first != args[0] { // grouped flags are consumed one by one
+			continue
+		}
+		if strict || args[0] == "--" || (args[0] != "-" && strings.HasPrefix(args[0], "-")) {
+			break
+		}
+		positionals = append(positionals, args[0])
+		offsets = append(offsets, len(args))
+		args = args[1:]
+	}
+	return positionals, offsets, args, nil
+}
+
+// relocate converts the remains of err (if it is *ParseError) from the number of
+// positional arguments starting from the offending one, into the number of argv
+// items by offsets (see command.extract).
+func relocate(err error, offsets []int) error {
+	var parse *ParseError
+	if errors.As(err, &parse) && parse.remains > 0 && parse.remains <= len(offsets) {
+		parse.remains = offsets[len(offsets)-parse.remains]
 	}
-	return args
+	return err
 }
 
 func (c command) String(width int) string {
@@ -184,7 +253,7 @@ func (c command) usage(path string, summaries ...string) string {
 	var text, args strings.Builder
 	if c.arguments.Count() > 0 || c.variadic.Allowed() {
 		args.WriteString("[--] ")
-		args.WriteString(strings.Join(c.arguments.Names(), " "))
+		args.WriteString(c.arguments.Arg())
 		if c.arguments.Count() > 0 {
 			args.WriteString(" ")
 		}
@@ -225,3 +294,31 @@ func (c command) Namespace() api.Namespace {
 func (c command) Help() string {
 	return c.usage(c.name)
 }
+
+func (c command) Usage(path string) string {
+	return c.usage(path)
+}
+
+func (c command) Statement(path string) string {
+	return c.statement.String(path)
+}
+
+func (c command) Options() api.Options {
+	return c.opts
+}
+
+func (c command) Flags() api.Flags {
+	return c.flags
+}
+
+func (c command) Arguments() api.Arguments {
+	return c.arguments
+}
+
+func (c command) Variadic() api.Variadic {
+	return c.variadic
+}
+
+func (c command) Commands() []api.Command {
+	return nil
+}
@@ -0,0 +1,75 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInterspersedOptionsAndStrictMode(t *testing.T) {
+	copying := func(implementation Implementation) command {
+		return Command("copy", "copy files", Statements(),
+			Options(Option("m", "mode", "file mode", "")), Flags(Flag("f", "force", "overwrite files")),
+			Arguments(Argument("SRC", "source")), Variadic("DST", "destinations"), implementation)
+	}
+	gnu := func(implementation Implementation) Application {
+		return Nested("tool", "a tool", Statements(), Options(), Flags(), Group("Commands", copying(implementation)))
+	}
+	strict := func(implementation Implementation) Application {
+		return Nested("tool", "a tool", Statements(), Options(), Flags(), Group("Commands", copying(implementation).Strict()))
+	}
+	posix := map[string]string{"POSIXLY_CORRECT": "1"}
+	tests := []struct {
+		app      func(Implementation) Application
+		env      map[string]string
+		args     []string
+		src      string
+		dst      []string
+		force    bool
+		mode     string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{gnu, nil, []string{"tool", "copy", "a", "b", "--force"}, "a", []string{"b"}, true, "", Unspecified, "", 0},
+		{gnu, nil, []string{"tool", "copy", "--force", "a", "-m", "644", "b", "c"}, "a", []string{"b", "c"}, true, "644", Unspecified, "", 0},
+		{gnu, nil, []string{"tool", "copy", "a", "-fm644", "b"}, "a", []string{"b"}, true, "644", Unspecified, "", 0},
+		{gnu, nil, []string{"tool", "copy", "a", "--", "--force", "-"}, "a", []string{"--force", "-"}, false, "", Unspecified, "", 0},
+		{gnu, nil, []string{"tool", "copy", "-", "b"}, "-", []string{"b"}, false, "", Unspecified, "", 0},
+		{gnu, nil, []string{"tool", "copy", "a", "--bogus", "b"}, "", nil, false, "", UnknownOptionOrFlag, "--bogus", 3},
+		{gnu, nil, []string{"tool", "copy", "a", "b", "-m"}, "", nil, false, "", MissingOptionValue, "-m", 4},
+		{gnu, nil, []string{"tool", "copy", "--force"}, "", nil, false, "", MissingArgument, "", 3},
+		{strict, nil, []string{"tool", "copy", "-f", "a", "b", "--force"}, "a", []string{"b", "--force"}, true, "", Unspecified, "", 0},
+		{strict, nil, []string{"tool", "copy", "a", "-m", "644"}, "a", []string{"-m", "644"}, false, "", Unspecified, "", 0},
+		{gnu, posix, []string{"tool", "copy", "a", "b", "--force"}, "a", []string{"b", "--force"}, false, "", Unspecified, "", 0},
+		{gnu, posix, []string{"tool", "copy", "--force", "a", "b"}, "a", []string{"b"}, true, "", Unspecified, "", 0},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, test.app, test.env, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if src := ctx.Argument("SRC"); src != test.src {
+			t.Errorf("%v: SRC is %q, want %q", test.args, src, test.src)
+		}
+		if dst := ctx.Variadic(); !reflect.DeepEqual(dst, test.dst) {
+			t.Errorf("%v: DST is %q, want %q", test.args, dst, test.dst)
+		}
+		if force, mode := ctx.Flag("force"), ctx.Option("mode"); force != test.force || mode != test.mode {
+			t.Errorf("%v: force is %t and mode is %q, want %t and %q", test.args, force, mode, test.force, test.mode)
+		}
+	}
+}
@@ -53,9 +53,9 @@ type _commands struct {
 	nameWidth int
 }
 
-func (c _commands) Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error) {
+func (c _commands) Exec(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error) {
 	for _, cmd := range c.cmds {
-		ok, err := cmd.Exec(path, options, flags, args)
+		ok, err := cmd.Exec(path, sources, options, flags, values, args)
 		if err != nil {
 			return ok, err
 		}
@@ -66,6 +66,10 @@ func (c _commands) Exec(path []string, options map[string]string, flags map[stri
 	return false, nil
 }
 
+func (c _commands) List() []api.Command {
+	return c.cmds
+}
+
 func (c _commands) Names() []string {
 	names := make([]string, len(c.cmds))
 	for i, cmd := range c.cmds {
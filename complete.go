@@ -0,0 +1,253 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// complete prints the completion candidates of the last item of args (the word being
+// completed), one per line, followed by a line holding the directive (e.g. :2). args
+// are the items which follow (app __complete), the hidden command is called by the
+// scripts generated by cli.CompletionScript.
+func complete(root node, args []string, sources _sources) error {
+	word := ""
+	if len(args) > 0 {
+		word, args = args[len(args)-1], args[:len(args)-1]
+	}
+	p := parse(root, args, sources)
+	candidates, directive := p.candidates(word)
+	var text strings.Builder
+	for _, candidate := range candidates {
+		value, _, _ := strings.Cut(candidate, "\t")
+		if strings.HasPrefix(value, word) {
+			text.WriteString(candidate)
+			text.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(&text, ":%d\n", directive)
+	_, err := io.WriteString(sources.stdout, text.String())
+	return err
+}
+
+// partial is the outcome of parsing an incomplete command line.
+type partial struct {
+	node        node
+	sources     _sources
+	options     map[string]string
+	flags       map[string]bool
+	values      map[string]any
+	positionals []string
+	// pending is the option which the word being completed is its value.
+	pending api.Option
+	dashed  bool
+}
+
+// parse walks the tree the same way the application does, options and flags are
+// extracted, commands are selected, while mistakes are ignored.
+func parse(root node, args []string, sources _sources) partial {
+	p := partial{
+		node:    root,
+		sources: sources,
+		options: make(map[string]string),
+		flags:   make(map[string]bool),
+		values:  make(map[string]any),
+	}
+	for len(args) > 0 {
+		if p.dashed {
+			p.positionals = append(p.positionals, args...)
+			break
+		}
+		if args[0] == "--" {
+			p.dashed = true
+			args = args[1:]
+			continue
+		}
+		length, first := len(args), args[0]
+		args = p.extract(args)
+		if len(args) == 0 || length != len(args) || first != args[0] {
+			continue
+		}
+		if child, ok := p.child(args[0]); ok {
+			p.settle()
+			p.node = child
+			args = args[1:]
+			continue
+		}
+		if strings.HasPrefix(args[0], "-") && args[0] != "-" {
+			if len(args) == 1 {
+				p.pending = p.option(args[0])
+			}
+			args = args[1:]
+			continue
+		}
+		p.positionals = append(p.positionals, args[0])
+		args = args[1:]
+	}
+	p.settle()
+	return p
+}
+
+// extract consumes an option or a flag of the current node from args, an invalid
+// value is skipped.
+func (p *partial) extract(args []string) []string {
+	for _, option := range p.node.options {
+		rest, err := option.Extract(p.options, p.values, args)
+		if err != nil {
+			return args[1:]
+		}
+		if len(rest) != len(args) {
+			return rest
+		}
+	}
+	for _, flag := range p.node.flags {
+		length, first := len(args), args[0]
+		rest, err := flag.Extract(p.flags, p.values, args)
+		if err != nil {
+			return args[1:]
+		}
+		if len(rest) != length || (len(rest) > 0 && rest[0] != first) {
+			return rest
+		}
+	}
+	return args
+}
+
+// child returns the sub command of the current node named name, commands can
+// be selected only before any positional argument.
+func (p *partial) child(name string) (node, bool) {
+	if len(p.positionals) > 0 {
+		return node{}, false
+	}
+	for _, child := range p.node.children() {
+		if child.name() == name {
+			return child, true
+		}
+	}
+	return node{}, false
+}
+
+// option returns the option of the current node named by spelling e.g. --output.
+func (p *partial) option(spelling string) api.Option {
+	for _, option := range p.node.options {
+		for _, name := range spellings(option.SName(), option.LName()) {
+			if name == spelling {
+				return option
+			}
+		}
+	}
+	return nil
+}
+
+// settle sets values of options and flags of the current node, which are not
+// given on the command line, from sources and default values.
+func (p *partial) settle() {
+	for _, option := range p.node.options {
+		resolveOption(p.node.path, p.sources, option, p.options, p.values)
+		option.Default(p.options, p.values)
+	}
+	for _, flag := range p.node.flags {
+		resolveFlag(p.node.path, p.sources, flag, p.flags, p.values)
+		flag.Default(p.flags, p.values)
+	}
+}
+
+func (p *partial) candidates(word string) ([]string, Directive) {
+	if p.pending != nil {
+		return p.value(p.pending, word)
+	}
+	if p.dashed || !strings.HasPrefix(word, "-") {
+		if children := p.node.children(); len(children) > 0 && len(p.positionals) == 0 && !p.dashed {
+			candidates := make([]string, len(children))
+			for i, child := range children {
+				candidates[i] = child.name() + "\t" + child.description
+			}
+			return candidates, CompleteNoFiles
+		}
+		return p.argument(word)
+	}
+	if name, value, ok := strings.Cut(word, "="); ok && strings.HasPrefix(name, "--") {
+		option := p.option(name)
+		if option == nil {
+			return nil, CompleteNoFiles
+		}
+		candidates, directive := p.value(option, value)
+		for i, candidate := range candidates {
+			candidates[i] = name + "=" + candidate
+		}
+		return candidates, directive
+	}
+	var candidates []string
+	for _, option := range p.node.options {
+		for _, name := range spellings(option.SName(), option.LName()) {
+			candidates = append(candidates, name+"\t"+option.Description())
+		}
+	}
+	for _, flag := range p.node.flags {
+		for _, name := range spellings(flag.SName(), flag.LName()) {
+			candidates = append(candidates, name+"\t"+flag.Description())
+		}
+		if negatable(flag) {
+			candidates = append(candidates, "--no-"+flag.LName()+"\t"+flag.Description())
+		}
+	}
+	return candidates, CompleteNoFiles
+}
+
+// value returns the candidates of the value of option.
+func (p *partial) value(option api.Option, word string) ([]string, Directive) {
+	if completer := option.Completer(); completer != nil {
+		return p.complete(completer, word)
+	}
+	if choices := option.Choices(); len(choices) > 0 {
+		return choices, CompleteNoFiles
+	}
+	return nil, CompleteDefault
+}
+
+// argument returns the candidates of the next positional argument.
+func (p *partial) argument(word string) ([]string, Directive) {
+	index := len(p.positionals)
+	if index < len(p.node.arguments) {
+		if completer := p.node.arguments[index].Completer(); completer != nil {
+			return p.complete(completer, word)
+		}
+		return nil, CompleteDefault
+	}
+	if p.node.variadic.Allowed() {
+		return nil, CompleteDefault
+	}
+	return nil, CompleteNoFiles
+}
+
+// complete returns the candidates of completer, given the values parsed so far.
+func (p *partial) complete(completer api.Completer, word string) ([]string, Directive) {
+	named := make(map[string]string, len(p.node.arguments))
+	values := make(map[string]any, len(p.node.arguments))
+	rest := p.positionals
+	for _, argument := range p.node.arguments {
+		next, err := argument.Extract(named, values, rest)
+		if err != nil {
+			next = rest[1:]
+		}
+		rest = next
+	}
+	candidates, directive := completer.Complete(p.node.path, p.sources, p.options, p.flags, p.values, named, values, rest, word)
+	return candidates, Directive(directive)
+}
@@ -0,0 +1,61 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		// commands of the root and after its options
+		{[]string{""}, []string{"deploy\tDeploy the given services.", "status\tShow the status of the services.", "remote\tManage remotes.", ":2"}},
+		{[]string{"-v", "--config", "x.json", "remote", ""}, []string{"add\tAdd a remote.", "remove\tRemove a remote.", ":2"}},
+		{[]string{"-c=x.json", "remote", "re"}, []string{"remove\tRemove a remote.", ":2"}},
+		{[]string{"-vv", "remote", "remove", ""}, []string{"origin", "upstream", ":2"}},
+		// pending option values
+		{[]string{"deploy", "-b", ""}, []string{"main\tdefault branch", "develop", ":2"}},
+		{[]string{"deploy", "--branch", "d"}, []string{"develop", ":2"}},
+		{[]string{"deploy", "--branch=d"}, []string{"--branch=develop", ":2"}},
+		{[]string{"deploy", "-e", ""}, []string{"dev", "prod", ":2"}},
+		{[]string{"deploy", "--env=p"}, []string{"--env=prod", ":2"}},
+		{[]string{"-c", ""}, []string{":0"}},
+		// options and flags
+		{[]string{"deploy", "--"}, []string{"--env\ttarget environment", "--branch\tbranch to deploy", "--push\tpush the images", "--no-push\tpush the images", ":2"}},
+		{[]string{"deploy", "--branch", "main", "--p"}, []string{"--push\tpush the images", ":2"}},
+		// everything after -- is an argument
+		{[]string{"deploy", "--", "-"}, []string{":0"}},
+		{[]string{"--", ""}, []string{":2"}},
+		{[]string{"remote", "remove", "--", ""}, []string{"origin", "upstream", ":2"}},
+		{[]string{"remote", "remove", "--", "origin", ""}, []string{":2"}},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		args := append([]string{"tool", "__complete"}, test.args...)
+		if code := Execute(Completion(fixture()), args, &stdout, &stderr); code != ExitSuccess {
+			t.Errorf("%q: exit %d: %s", test.args, code, stderr.String())
+			continue
+		}
+		want := strings.Join(test.want, "\n") + "\n"
+		if got := stdout.String(); got != want {
+			t.Errorf("%q:\ngot:\n%s\nwant:\n%s", test.args, got, want)
+		}
+	}
+}
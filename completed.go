@@ -0,0 +1,79 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"github.com/begopher/cli/internal/api"
+)
+
+// Completed attaches complete function to the given option, which returns the
+// candidates of the option value when the end user presses tab, see cli.Completion.
+//
+//	cli.Completed(cli.Option("b", "branch", "branch name", ""), func(ctx cli.Context, partial string) ([]string, cli.Directive) {
+//		return branches(ctx.Option("repo")), cli.CompleteNoFiles
+//	})
+//
+// # Panic when:
+//   - option is nil.
+//   - complete is nil.
+func Completed(option api.Option, complete Completer) completed {
+	if option == nil {
+		panic("cli.Completed: option cannot be nil")
+	}
+	if complete == nil {
+		panic("cli.Completed: complete cannot be nil")
+	}
+	return completed{
+		Option:   option,
+		complete: complete,
+	}
+}
+
+type completed struct {
+	api.Option
+	complete Completer
+}
+
+func (c completed) Completer() api.Completer {
+	return completer{c.complete}
+}
+
+// CompletedArgument attaches complete function to the given argument, which returns
+// the candidates of the argument when the end user presses tab, see cli.Completed.
+//
+// # Panic when:
+//   - argument is nil.
+//   - complete is nil.
+func CompletedArgument(argument api.Argument, complete Completer) completedArgument {
+	if argument == nil {
+		panic("cli.CompletedArgument: argument cannot be nil")
+	}
+	if complete == nil {
+		panic("cli.CompletedArgument: complete cannot be nil")
+	}
+	return completedArgument{
+		Argument: argument,
+		complete: complete,
+	}
+}
+
+type completedArgument struct {
+	api.Argument
+	complete Completer
+}
+
+func (c completedArgument) Completer() api.Completer {
+	return completer{c.complete}
+}
@@ -0,0 +1,247 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// Completion returns app with a built-in command (completion SHELL), which prints
+// the completion script of the given shell (bash, zsh or fish) to the standard output.
+// The script completes commands, options and flags (including the choices of
+// cli.EnumOption) of the whole application tree, e.g.
+//
+//	source <(tool completion bash)
+//	tool completion zsh > "${fpath[1]}/_tool"
+//	tool completion fish > ~/.config/fish/completions/tool.fish
+//
+// Values of options and arguments are completed by the application itself, through
+// a hidden command (app __complete ARGS... WORD) which is called by the script when
+// cli.Completed or cli.CompletedArgument is used. It parses ARGS the same way the
+// application does, and prints the candidates of WORD one per line followed by the
+// directive (e.g. :2), see cli.Directive.
+//
+// The built-in commands are recognized only as the first argument of the application,
+// therefore cli.Simple applications cannot accept completion or __complete as their
+// first argument. The completion command is listed in the usage of the application.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+//   - app already has a command named completion or __complete.
+func Completion(app Application) Application {
+	runner := runnable("cli.Completion", app)
+	for _, child := range runner.tree().children() {
+		if name := child.name(); name == "completion" || name == "__complete" {
+			panic(fmt.Sprintf("cli.Completion: app already has a command named %s", name))
+		}
+	}
+	return completion{
+		app: runner.builtin("completion", completionDescription),
+	}
+}
+
+const completionDescription = "Print the completion script of the given shell."
+
+type completion struct {
+	app runner
+}
+
+func (c completion) Run(args []string) error {
+	return c.start(args, sources())
+}
+
+func (c completion) builtin(name, description string) runner {
+	c.app = c.app.builtin(name, description)
+	return c
+}
+
+func (c completion) tree() node {
+	return c.app.tree()
+}
+
+func (c completion) start(args []string, sources _sources) error {
+	if len(args) > 1 && args[1] == "__complete" {
+		return complete(c.app.tree(), args[2:], sources)
+	}
+	if len(args) < 2 || args[1] != "completion" {
+		return c.app.start(args, sources)
+	}
+	root := c.app.tree()
+	shell := ValidatedArgument(Argument("SHELL", "bash, zsh or fish"), func(shell string) error {
+		_, err := script(root, shell)
+		return err
+	})
+	implementation := Function(func(ctx Context) error {
+		text, err := script(root, ctx.Argument("SHELL"))
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(sources.stdout, text)
+		return err
+	})
+	cmd := Command("completion", completionDescription, Statements(),
+		Options(), Flags(), Arguments(shell), NoVariadic(), implementation)
+	options := make(map[string]string)
+	flags := make(map[string]bool)
+	values := make(map[string]any)
+	_, err := cmd.Exec(root.path, sources, options, flags, values, args[1:])
+	return locate(err, len(args))
+}
+
+// CompletionScript returns the completion script of app for the given shell, which is
+// one of bash, zsh or fish. The script is identical to what the built-in command
+// (app completion SHELL) prints, see cli.Completion, and it is deterministic so it
+// can be compared against golden files.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+func CompletionScript(app Application, shell string) (string, error) {
+	return script(runnable("cli.CompletionScript", app).tree(), shell)
+}
+
+func script(root node, shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return bash(root), nil
+	case "zsh":
+		return zsh(root), nil
+	case "fish":
+		return fish(root), nil
+	}
+	return "", errors.New("one of (bash, zsh, fish) is expected")
+}
+
+// identifier returns name as a valid shell function name.
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// transitions returns the full paths of all descendants of root, which the end
+// user reaches by typing their names.
+func transitions(root node) []string {
+	var paths []string
+	root.walk(func(n node) {
+		if len(n.path) > len(root.path) {
+			paths = append(paths, n.fullPath())
+		}
+	})
+	return paths
+}
+
+// switches returns the names (e.g. -o, --output) of all options and flags of n.
+func switches(n node) []string {
+	var names []string
+	for _, option := range n.options {
+		names = append(names, spellings(option.SName(), option.LName())...)
+	}
+	for _, flag := range n.flags {
+		names = append(names, spellings(flag.SName(), flag.LName())...)
+		if negatable(flag) {
+			names = append(names, "--no-"+flag.LName())
+		}
+	}
+	return names
+}
+
+// spellings returns the names of an option or flag as typed by the end user.
+func spellings(sname, lname string) []string {
+	var names []string
+	if sname != "" {
+		names = append(names, "-"+sname)
+	}
+	if lname != "" {
+		names = append(names, "--"+lname)
+	}
+	return names
+}
+
+// negatable reports whether flag accepts --no-name, see cli.NegatableFlag.
+func negatable(flag api.Flag) bool {
+	return strings.HasPrefix(flag.Label(), "--[no-]")
+}
+
+// attached reports whether option accepts only a value attached to its name, so the
+// next word is never its value, see cli.OptionalOption.
+func attached(option api.Option) bool {
+	return option.Kind().Attached
+}
+
+// separated returns the options which take the next word as their value, which are
+// all options except the attached ones.
+func separated(options []api.Option) []api.Option {
+	var separated []api.Option
+	for _, option := range options {
+		if !attached(option) {
+			separated = append(separated, option)
+		}
+	}
+	return separated
+}
+
+// dynamic reports whether the value of option is completed by the application.
+func dynamic(option api.Option) bool {
+	return option.Completer() != nil
+}
+
+// dynamicArguments reports whether an argument of n is completed by the application.
+func dynamicArguments(n node) bool {
+	for _, argument := range n.arguments {
+		if argument.Completer() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// dynamicTree reports whether any value in the tree of root is completed by the
+// application, where the script needs to call the hidden __complete command.
+func dynamicTree(root node) bool {
+	found := false
+	root.walk(func(n node) {
+		for _, option := range n.options {
+			found = found || dynamic(option)
+		}
+		found = found || dynamicArguments(n)
+	})
+	return found
+}
+
+// positionals returns the arguments and variadic of n as printed in the usage line.
+func positionals(n node) string {
+	var names []string
+	for _, argument := range n.arguments {
+		names = append(names, argument.Arg())
+	}
+	if n.variadic.Allowed() {
+		names = append(names, n.variadic.Arg())
+	}
+	return strings.Join(names, " ")
+}
+
+// quoted returns names as a double quoted shell word.
+func quoted(names []string) string {
+	return `"` + strings.Join(names, " ") + `"`
+}
@@ -0,0 +1,132 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bash returns the bash completion script of root, see cli.Completion.
+func bash(root node) string {
+	name := root.name()
+	function := "_" + identifier(name)
+	var text strings.Builder
+	helper := "__" + identifier(name) + "_complete"
+	fmt.Fprintf(&text, "# bash completion for %s, generated by github.com/begopher/cli\n\n", name)
+	if dynamicTree(root) {
+		fmt.Fprintf(&text, "%s() {\n", helper)
+		text.WriteString("\tlocal output line directive\n")
+		text.WriteString("\toutput=\"$(\"${COMP_WORDS[0]}\" __complete \"${COMP_WORDS[@]:1:COMP_CWORD}\" 2>/dev/null)\" || return\n")
+		text.WriteString("\tdirective=\"${output##*:}\"\n")
+		text.WriteString("\tCOMPREPLY=()\n")
+		text.WriteString("\twhile IFS= read -r line; do\n")
+		text.WriteString("\t\t[[ -z \"$line\" || \"$line\" == :* ]] && continue\n")
+		text.WriteString("\t\tCOMPREPLY+=(\"${line%%$'\\t'*}\")\n")
+		text.WriteString("\tdone <<<\"$output\"\n")
+		fmt.Fprintf(&text, "\tif ((directive & %d)); then\n", CompleteNoSpace)
+		text.WriteString("\t\tcompopt -o nospace\n")
+		text.WriteString("\tfi\n")
+		fmt.Fprintf(&text, "\tif ((directive & %d)); then\n", CompleteNoFiles)
+		text.WriteString("\t\tcompopt +o default\n")
+		text.WriteString("\tfi\n")
+		text.WriteString("\tif ((${#COMPREPLY[@]} == 0)); then\n")
+		fmt.Fprintf(&text, "\t\tif ((directive & %d)); then\n", CompleteDirectories)
+		text.WriteString("\t\t\tCOMPREPLY=($(compgen -d -- \"$cur\"))\n")
+		fmt.Fprintf(&text, "\t\telif ((directive & %d)); then\n", CompleteFiles)
+		text.WriteString("\t\t\tCOMPREPLY=($(compgen -f -- \"$cur\"))\n")
+		text.WriteString("\t\tfi\n")
+		text.WriteString("\tfi\n")
+		text.WriteString("}\n\n")
+	}
+	fmt.Fprintf(&text, "%s() {\n", function)
+	text.WriteString("\tlocal cur=\"${COMP_WORDS[COMP_CWORD]}\" prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+	fmt.Fprintf(&text, "\tlocal cmdpath=%s word i\n", quoted(root.path))
+	if paths := transitions(root); len(paths) > 0 {
+		text.WriteString("\tfor ((i = 1; i < COMP_CWORD; i++)); do\n")
+		text.WriteString("\t\tword=\"${COMP_WORDS[i]}\"\n")
+		text.WriteString("\t\tcase \"$cmdpath $word\" in\n")
+		fmt.Fprintf(&text, "\t\t%s) cmdpath=\"$cmdpath $word\" ;;\n", alternatives(paths, " | "))
+		text.WriteString("\t\tesac\n")
+		text.WriteString("\tdone\n")
+	}
+	if dynamicTree(root) {
+		text.WriteString("\tlocal commands=\"\" switches=\"\" arguments=\"\" dynamic=\"\"\n")
+	} else {
+		text.WriteString("\tlocal commands=\"\" switches=\"\" arguments=\"\"\n")
+	}
+	text.WriteString("\tcase \"$cmdpath\" in\n")
+	root.walk(func(n node) {
+		fmt.Fprintf(&text, "\t%s)\n", quoted(n.path))
+		var commands []string
+		for _, child := range n.children() {
+			commands = append(commands, child.name())
+		}
+		fmt.Fprintf(&text, "\t\tcommands=%s\n", quoted(commands))
+		fmt.Fprintf(&text, "\t\tswitches=%s\n", quoted(switches(n)))
+		fmt.Fprintf(&text, "\t\targuments=%s\n", quoted([]string{positionals(n)}))
+		if dynamicArguments(n) {
+			text.WriteString("\t\tdynamic=1\n")
+		}
+		if options := separated(n.options); len(options) > 0 {
+			text.WriteString("\t\tcase \"$prev\" in\n")
+			for _, option := range options {
+				names := strings.Join(spellings(option.SName(), option.LName()), " | ")
+				if dynamic(option) {
+					fmt.Fprintf(&text, "\t\t%s)\n", names)
+					fmt.Fprintf(&text, "\t\t\t%s\n", helper)
+					text.WriteString("\t\t\treturn\n")
+					text.WriteString("\t\t\t;;\n")
+					continue
+				}
+				if choices := option.Choices(); len(choices) > 0 {
+					fmt.Fprintf(&text, "\t\t%s)\n", names)
+					fmt.Fprintf(&text, "\t\t\tCOMPREPLY=($(compgen -W %s -- \"$cur\"))\n", quoted(choices))
+					text.WriteString("\t\t\treturn\n")
+					text.WriteString("\t\t\t;;\n")
+					continue
+				}
+				fmt.Fprintf(&text, "\t\t%s) return ;;\n", names)
+			}
+			text.WriteString("\t\tesac\n")
+		}
+		text.WriteString("\t\t;;\n")
+	})
+	text.WriteString("\tesac\n")
+	text.WriteString("\tif [[ \"$cur\" == -* ]]; then\n")
+	text.WriteString("\t\tCOMPREPLY=($(compgen -W \"$switches\" -- \"$cur\"))\n")
+	text.WriteString("\telif [[ -n \"$commands\" ]]; then\n")
+	text.WriteString("\t\tCOMPREPLY=($(compgen -W \"$commands\" -- \"$cur\"))\n")
+	if dynamicTree(root) {
+		text.WriteString("\telif [[ -n \"$dynamic\" ]]; then\n")
+		fmt.Fprintf(&text, "\t\t%s\n", helper)
+	}
+	// paths are completed only where arguments are accepted, as in zsh and fish
+	text.WriteString("\telif [[ -z \"$arguments\" ]]; then\n")
+	text.WriteString("\t\tcompopt +o default\n")
+	text.WriteString("\tfi\n")
+	text.WriteString("}\n\n")
+	fmt.Fprintf(&text, "complete -o default -F %s %s\n", function, name)
+	return text.String()
+}
+
+// alternatives returns paths as double quoted case patterns separated by separator.
+func alternatives(paths []string, separator string) string {
+	patterns := make([]string, len(paths))
+	for i, path := range paths {
+		patterns[i] = `"` + path + `"`
+	}
+	return strings.Join(patterns, separator)
+}
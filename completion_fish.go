@@ -0,0 +1,108 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fish returns the fish completion script of root, see cli.Completion.
+func fish(root node) string {
+	name := root.name()
+	function := "__" + identifier(name) + "_path"
+	var text strings.Builder
+	fmt.Fprintf(&text, "# fish completion for %s, generated by github.com/begopher/cli\n\n", name)
+	fmt.Fprintf(&text, "function %s\n", function)
+	fmt.Fprintf(&text, "\tset -l cmdpath %s\n", quoted(root.path))
+	if paths := transitions(root); len(paths) > 0 {
+		text.WriteString("\tfor word in (commandline -opc)[2..-1]\n")
+		text.WriteString("\t\tswitch \"$cmdpath $word\"\n")
+		fmt.Fprintf(&text, "\t\t\tcase %s\n", alternatives(paths, " "))
+		text.WriteString("\t\t\t\tset cmdpath \"$cmdpath $word\"\n")
+		text.WriteString("\t\tend\n")
+		text.WriteString("\tend\n")
+	}
+	text.WriteString("\techo $cmdpath\n")
+	text.WriteString("end\n\n")
+	helper := "__" + identifier(name) + "_complete"
+	if dynamicTree(root) {
+		fmt.Fprintf(&text, "function %s\n", helper)
+		text.WriteString("\tset -l words (commandline -opc) (commandline -ct)\n")
+		text.WriteString("\tset -l output ($words[1] __complete $words[2..-1] 2>/dev/null)\n")
+		text.WriteString("\ttest (count $output) -gt 0; or return\n")
+		text.WriteString("\tset -l directive (string replace ':' '' -- $output[-1])\n")
+		text.WriteString("\tset -e output[-1]\n")
+		text.WriteString("\tfor line in $output\n")
+		text.WriteString("\t\techo $line\n")
+		text.WriteString("\tend\n")
+		text.WriteString("\tif test (count $output) -eq 0\n")
+		fmt.Fprintf(&text, "\t\tif test (math \"bitand($directive, %d)\") -ne 0\n", CompleteDirectories)
+		text.WriteString("\t\t\t__fish_complete_directories (commandline -ct)\n")
+		fmt.Fprintf(&text, "\t\telse if test (math \"bitand($directive, %d)\") -ne 0 -o (math \"bitand($directive, %d)\") -eq 0\n", CompleteFiles, CompleteNoFiles)
+		text.WriteString("\t\t\t__fish_complete_path (commandline -ct)\n")
+		text.WriteString("\t\tend\n")
+		text.WriteString("\tend\n")
+		text.WriteString("end\n\n")
+	}
+	fmt.Fprintf(&text, "complete -c %s -f\n", name)
+	root.walk(func(n node) {
+		prefix := fmt.Sprintf("complete -c %s -n %s", name, single(fmt.Sprintf("test (%s) = %s", function, quoted(n.path))))
+		for _, child := range n.children() {
+			fmt.Fprintf(&text, "%s -a %s -d %s\n", prefix, child.name(), single(child.description))
+		}
+		for _, option := range n.options {
+			value := " -r"
+			if attached(option) {
+				value = ""
+			} else if dynamic(option) {
+				value = " -x -a " + single("("+helper+")")
+			} else if choices := option.Choices(); len(choices) > 0 {
+				value = " -x -a " + single(strings.Join(choices, " "))
+			}
+			fmt.Fprintf(&text, "%s%s%s -d %s\n", prefix, names(option.SName(), option.LName()), value, single(option.Description()))
+		}
+		for _, flag := range n.flags {
+			fmt.Fprintf(&text, "%s%s -d %s\n", prefix, names(flag.SName(), flag.LName()), single(flag.Description()))
+			if negatable(flag) {
+				fmt.Fprintf(&text, "%s -l no-%s -d %s\n", prefix, flag.LName(), single(flag.Description()))
+			}
+		}
+		if dynamicArguments(n) {
+			fmt.Fprintf(&text, "%s -a %s\n", prefix, single("("+helper+")"))
+		} else if positionals(n) != "" {
+			fmt.Fprintf(&text, "%s -F\n", prefix)
+		}
+	})
+	return text.String()
+}
+
+// names returns the -s and -l arguments of fish complete command.
+func names(sname, lname string) string {
+	var text string
+	if sname != "" {
+		text += " -s " + sname
+	}
+	if lname != "" {
+		text += " -l " + lname
+	}
+	return text
+}
+
+// single returns text as a single quoted fish word.
+func single(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\\`)
+	return "'" + strings.ReplaceAll(text, "'", `\'`) + "'"
+}
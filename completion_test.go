@@ -0,0 +1,101 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompletionScript(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		t.Run(shell, func(t *testing.T) {
+			text, err := CompletionScript(fixture(), shell)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden(t, "completion."+shell, text)
+		})
+	}
+	if _, err := CompletionScript(fixture(), "powershell"); err == nil {
+		t.Error("unexpected success of unknown shell")
+	}
+}
+
+func TestCompletionOfOptionalOption(t *testing.T) {
+	nothing := Function(func(Context) error { return nil })
+	app := Simple("tool", "A tool.", Statements(),
+		Options(OptionalOption("c", "color", "colorize the output", "WHEN", "always", "auto"), Option("o", "output", "output file", "")),
+		Flags(), Arguments(Argument("FILE", "input file")), NoVariadic(), nothing)
+	tests := []struct {
+		shell    string
+		separate string
+		attached string
+	}{
+		{"bash", "-o | --output) return ;;", "-c | --color)"},
+		{"zsh", "(-o | --output) _files; return ;;", "(-c | --color)"},
+		{"fish", "-s o -l output -r -d", "-s c -l color -r"},
+	}
+	for _, test := range tests {
+		text, err := CompletionScript(app, test.shell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// a bare --color is complete, the next word is not its value
+		if !strings.Contains(text, test.separate) || strings.Contains(text, test.attached) {
+			t.Errorf("%s: unexpected completion of options:\n%s", test.shell, text)
+		}
+	}
+	if text, _ := CompletionScript(app, "bash"); !strings.Contains(text, `arguments="FILE"`) {
+		t.Errorf("bash: arguments are not emitted:\n%s", text)
+	}
+}
+
+func TestCompletionCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := Execute(Completion(fixture()), []string{"tool", "completion", "fish"}, &stdout, &stderr)
+	if want, _ := CompletionScript(fixture(), "fish"); code != ExitSuccess || stdout.String() != want {
+		t.Errorf("completion fish: exit %d, stderr %q", code, stderr.String())
+	}
+	stdout.Reset()
+	stderr.Reset()
+	Execute(Completion(fixture()), []string{"tool", "--help"}, &stdout, &stderr)
+	if usage := stdout.String() + stderr.String(); !strings.Contains(usage, "Built-in commands:\n  completion  ") {
+		t.Errorf("completion is not listed in the usage:\n%s", usage)
+	}
+}
+
+func TestCompletionPanicsOnExistingCommand(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	nothing := Function(func(Context) error { return nil })
+	cmd := Command("completion", "Complete.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nothing)
+	Completion(Nested("tool", "A tool.", Statements(), Options(), Flags(), Group("Main", cmd)))
+}
+
+func TestCompletionPanicsOnHiddenCommand(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	nothing := Function(func(Context) error { return nil })
+	cmd := Command("__complete", "Complete.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nothing)
+	Completion(Nested("tool", "A tool.", Statements(), Options(), Flags(), Group("Main", cmd)))
+}
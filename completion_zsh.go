@@ -0,0 +1,144 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// zsh returns the zsh completion script of root, see cli.Completion.
+func zsh(root node) string {
+	name := root.name()
+	function := "_" + identifier(name)
+	var text strings.Builder
+	fmt.Fprintf(&text, "#compdef %s\n", name)
+	helper := "__" + identifier(name) + "_complete"
+	fmt.Fprintf(&text, "# zsh completion for %s, generated by github.com/begopher/cli\n\n", name)
+	if dynamicTree(root) {
+		fmt.Fprintf(&text, "%s() {\n", helper)
+		text.WriteString("\tlocal output line directive=0\n")
+		text.WriteString("\tlocal -a candidates options\n")
+		text.WriteString("\toutput=\"$(${words[1]} __complete \"${(@)words[2,CURRENT]}\" 2>/dev/null)\" || return\n")
+		text.WriteString("\tfor line in \"${(@f)output}\"; do\n")
+		text.WriteString("\t\tif [[ \"$line\" == :* ]]; then\n")
+		text.WriteString("\t\t\tdirective=\"${line#:}\"\n")
+		text.WriteString("\t\telif [[ \"$line\" == *$'\\t'* ]]; then\n")
+		text.WriteString("\t\t\tcandidates+=(\"${${line%%$'\\t'*}//:/\\\\:}:${line#*$'\\t'}\")\n")
+		text.WriteString("\t\telif [[ -n \"$line\" ]]; then\n")
+		text.WriteString("\t\t\tcandidates+=(\"${line//:/\\\\:}\")\n")
+		text.WriteString("\t\tfi\n")
+		text.WriteString("\tdone\n")
+		fmt.Fprintf(&text, "\tif (( directive & %d )); then\n", CompleteNoSpace)
+		text.WriteString("\t\toptions=(-S '')\n")
+		text.WriteString("\tfi\n")
+		text.WriteString("\tif (( ${#candidates} )); then\n")
+		text.WriteString("\t\t_describe -t values value candidates \"${options[@]}\"\n")
+		fmt.Fprintf(&text, "\telif (( directive & %d )); then\n", CompleteDirectories)
+		text.WriteString("\t\t_files -/\n")
+		fmt.Fprintf(&text, "\telif (( directive & %d )) || ! (( directive & %d )); then\n", CompleteFiles, CompleteNoFiles)
+		text.WriteString("\t\t_files\n")
+		text.WriteString("\tfi\n")
+		text.WriteString("}\n\n")
+	}
+	fmt.Fprintf(&text, "%s() {\n", function)
+	fmt.Fprintf(&text, "\tlocal cmdpath=%s word i\n", quoted(root.path))
+	if paths := transitions(root); len(paths) > 0 {
+		text.WriteString("\tfor ((i = 2; i < CURRENT; i++)); do\n")
+		text.WriteString("\t\tword=\"${words[i]}\"\n")
+		text.WriteString("\t\tcase \"$cmdpath $word\" in\n")
+		fmt.Fprintf(&text, "\t\t(%s) cmdpath=\"$cmdpath $word\" ;;\n", alternatives(paths, " | "))
+		text.WriteString("\t\tesac\n")
+		text.WriteString("\tdone\n")
+	}
+	text.WriteString("\tlocal -a commands switches\n")
+	if dynamicTree(root) {
+		text.WriteString("\tlocal arguments=\"\" dynamic=\"\"\n")
+	} else {
+		text.WriteString("\tlocal arguments=\"\"\n")
+	}
+	text.WriteString("\tcase \"$cmdpath\" in\n")
+	root.walk(func(n node) {
+		fmt.Fprintf(&text, "\t(%s)\n", quoted(n.path))
+		text.WriteString("\t\tcommands=(\n")
+		for _, child := range n.children() {
+			fmt.Fprintf(&text, "\t\t\t%s\n", described(child.name(), child.description))
+		}
+		text.WriteString("\t\t)\n")
+		text.WriteString("\t\tswitches=(\n")
+		for _, option := range n.options {
+			for _, spelling := range spellings(option.SName(), option.LName()) {
+				fmt.Fprintf(&text, "\t\t\t%s\n", described(spelling, option.Description()))
+			}
+		}
+		for _, flag := range n.flags {
+			for _, spelling := range spellings(flag.SName(), flag.LName()) {
+				fmt.Fprintf(&text, "\t\t\t%s\n", described(spelling, flag.Description()))
+			}
+			if negatable(flag) {
+				fmt.Fprintf(&text, "\t\t\t%s\n", described("--no-"+flag.LName(), flag.Description()))
+			}
+		}
+		text.WriteString("\t\t)\n")
+		if arguments := positionals(n); arguments != "" {
+			fmt.Fprintf(&text, "\t\targuments=%s\n", quoted([]string{arguments}))
+		}
+		if dynamicArguments(n) {
+			text.WriteString("\t\tdynamic=1\n")
+		}
+		if options := separated(n.options); len(options) > 0 {
+			text.WriteString("\t\tcase \"${words[CURRENT-1]}\" in\n")
+			for _, option := range options {
+				names := strings.Join(spellings(option.SName(), option.LName()), " | ")
+				if dynamic(option) {
+					fmt.Fprintf(&text, "\t\t(%s) %s; return ;;\n", names, helper)
+					continue
+				}
+				if choices := option.Choices(); len(choices) > 0 {
+					fmt.Fprintf(&text, "\t\t(%s) compadd -- %s; return ;;\n", names, strings.Join(choices, " "))
+					continue
+				}
+				fmt.Fprintf(&text, "\t\t(%s) _files; return ;;\n", names)
+			}
+			text.WriteString("\t\tesac\n")
+		}
+		text.WriteString("\t\t;;\n")
+	})
+	text.WriteString("\tesac\n")
+	text.WriteString("\tif [[ \"${words[CURRENT]}\" == -* ]]; then\n")
+	text.WriteString("\t\t_describe -t switches 'option or flag' switches\n")
+	text.WriteString("\telif (( ${#commands} )); then\n")
+	text.WriteString("\t\t_describe -t commands command commands\n")
+	if dynamicTree(root) {
+		text.WriteString("\telif [[ -n \"$dynamic\" ]]; then\n")
+		fmt.Fprintf(&text, "\t\t%s\n", helper)
+	}
+	text.WriteString("\telif [[ -n \"$arguments\" ]]; then\n")
+	text.WriteString("\t\t_alternative \"arguments:$arguments:_files\"\n")
+	text.WriteString("\tfi\n")
+	text.WriteString("}\n\n")
+	fmt.Fprintf(&text, "if [[ \"$funcstack[1]\" == \"%s\" ]]; then\n", function)
+	fmt.Fprintf(&text, "\t%s \"$@\"\n", function)
+	text.WriteString("else\n")
+	fmt.Fprintf(&text, "\tcompdef %s %s\n", function, name)
+	text.WriteString("fi\n")
+	return text.String()
+}
+
+// described returns name:description as a single quoted zsh word.
+func described(name, description string) string {
+	description = strings.ReplaceAll(description, ":", `\:`)
+	return "'" + strings.ReplaceAll(name+":"+description, "'", `'\''`) + "'"
+}
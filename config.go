@@ -0,0 +1,107 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// Config returns app which reads the values of options and flags, which are not given
+// on the command line nor by environment variables (see cli.Env), from the configuration
+// file (argv > env > config > default). The file is decoded by decoder every time the
+// application runs, a missing file is not an error, while an unreadable or malformed
+// file fails the selected command (after its --help is handled) naming the file.
+//
+// The root object holds the values of the application's options and flags, values of
+// a command are held by the object of its path, either nested or as a dotted section:
+//
+//	{
+//		"region": "eu",
+//		"remote": {"add": {"tags": ["a", "b"]}},
+//		"remote.remove": {"force": true}
+//	}
+//
+// Options and flags are looked up by their long name (or the short name when the long
+// one is empty). Values are interpreted exactly as if they were given on the command
+// line, arrays give repeatable options multiple values, and objects give map options
+// key=value entries.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+//   - file is empty.
+//   - decoder is nil.
+func Config(app Application, file string, decoder Decoder) Application {
+	runner := runnable("cli.Config", app)
+	file = strings.TrimSpace(file)
+	if file == "" {
+		panic("cli.Config: file cannot be empty")
+	}
+	if decoder == nil {
+		panic("cli.Config: decoder cannot be nil")
+	}
+	return config{
+		app:     runner,
+		file:    file,
+		decoder: decoder,
+	}
+}
+
+type config struct {
+	app     runner
+	file    string
+	decoder Decoder
+}
+
+func (c config) Run(args []string) error {
+	return c.start(args, sources())
+}
+
+func (c config) builtin(name, description string) runner {
+	c.app = c.app.builtin(name, description)
+	return c
+}
+
+func (c config) tree() node {
+	return c.app.tree()
+}
+
+func (c config) start(args []string, sources _sources) error {
+	data, err := os.ReadFile(c.file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c.app.start(args, sources)
+	}
+	if err != nil {
+		return c.broken(args, sources, fmt.Errorf("cannot read config file (%s): %w", c.file, err))
+	}
+	values, err := c.decoder.Decode(data)
+	if err != nil {
+		return c.broken(args, sources, fmt.Errorf("invalid config file (%s): %w", c.file, err))
+	}
+	sources.config = values
+	return c.app.start(args, sources)
+}
+
+// broken starts the application without the config file, where err is reported
+// by the selected command, so --help is not blocked by a broken config file.
+func (c config) broken(args []string, sources _sources, err error) error {
+	sources.Defer(func() error {
+		return err
+	})
+	return c.app.start(args, sources)
+}
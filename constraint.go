@@ -0,0 +1,227 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// constraint is a Statement which restricts how options and flags of a command
+// can be combined by the end user, it is checked after default values are set
+// and before the command is executed. See:
+//   - cli.ExactlyOneOf
+//   - cli.AtMostOneOf
+//   - cli.AllOrNone
+//   - cli.Requires
+type constraint interface {
+	Statement
+	// Check accepts the names of options and flags which are set by the end user,
+	// where flags which are explicitly lowered (e.g. --no-color) are false.
+	Check(given map[string]bool) error
+	// Names returns the names of constrained options and flags.
+	Names() []string
+}
+
+// given returns the names of options and flags which are set by the end user, on
+// the command line or through environment variables and config files, therefore it
+// must be invoked after options and flags are resolved, and before default values
+// are set. A flag which is explicitly lowered (e.g. --no-color or --color=false) is
+// set as well, but its name is false.
+func given(options map[string]string, flags map[string]bool) map[string]bool {
+	set := make(map[string]bool, len(options)+len(flags))
+	for name := range options {
+		set[name] = true
+	}
+	for name, raised := range flags {
+		set[name] = raised
+	}
+	return set
+}
+
+// rule is the constraint of cli.ExactlyOneOf, cli.AtMostOneOf, cli.AllOrNone and
+// cli.Requires, where violated decides whether the given names break the rule
+// described by text.
+type rule struct {
+	names    []string
+	text     string
+	violated func(given map[string]bool) bool
+}
+
+func (r rule) Check(given map[string]bool) error {
+	if r.violated(given) {
+		return violation(r.text, r.names)
+	}
+	return nil
+}
+
+func (r rule) Names() []string {
+	return r.names
+}
+
+func (r rule) String(path string) string {
+	return render(r, path)
+}
+
+func (rule) Empty() bool {
+	return false
+}
+
+// check checks the constraints (if any) in statement.
+func check(statement Statement, given map[string]bool) error {
+	if constraint, ok := statement.(constraint); ok {
+		return constraint.Check(given)
+	}
+	return nil
+}
+
+// verify panics when a constraint in statement refers to a name which is
+// neither an option nor a flag.
+func verify(caller string, statement Statement, options api.Options, flags api.Flags) {
+	constraint, ok := statement.(constraint)
+	if !ok {
+		return
+	}
+	known := make(map[string]bool)
+	for _, name := range append(options.Names(), flags.Names()...) {
+		known[name] = true
+	}
+	for _, name := range constraint.Names() {
+		if !known[name] {
+			msg := fmt.Sprintf("%s: constraint refers to unknown option or flag (%s)", caller, name)
+			panic(msg)
+		}
+	}
+}
+
+// constrained validates names given to a constraint constructor.
+func constrained(caller string, min int, names []string) []string {
+	if len(names) < min {
+		msg := fmt.Sprintf("%s: at least %d names are required", caller, min)
+		panic(msg)
+	}
+	namespace := namespace()
+	for _, name := range names {
+		if name == "" || strings.HasPrefix(name, "-") {
+			msg := fmt.Sprintf("%s: name (%s) cannot be empty or start with -", caller, name)
+			panic(msg)
+		}
+		if err := namespace.Add(name); err != nil {
+			msg := fmt.Sprintf("%s: name (%s) is duplicated", caller, name)
+			panic(msg)
+		}
+	}
+	return append([]string(nil), names...)
+}
+
+// count returns how many of names are given, explicitly lowered flags included.
+func count(given map[string]bool, names []string) int {
+	var n int
+	for _, name := range names {
+		if _, ok := given[name]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// raised returns how many of names are given, explicitly lowered flags excluded.
+func raised(given map[string]bool, names []string) int {
+	var n int
+	for _, name := range names {
+		if given[name] {
+			n++
+		}
+	}
+	return n
+}
+
+// hyphenate returns names as they are typed by the end user e.g. (--all, -s).
+func hyphenate(names []string) string {
+	hyphenated := make([]string, len(names))
+	for i, name := range names {
+		if len([]rune(name)) == 1 {
+			hyphenated[i] = "-" + name
+		} else {
+			hyphenated[i] = "--" + name
+		}
+	}
+	return fmt.Sprintf("(%s)", strings.Join(hyphenated, ", "))
+}
+
+// render returns the text of statement followed by its constraints (if any) as a
+// paragraph titled Constraints, so they are not mixed with the preceding text.
+func render(statement Statement, path string) string {
+	var text strings.Builder
+	text.WriteString(plain(statement, path))
+	lines := rules(statement)
+	if len(lines) == 0 {
+		return text.String()
+	}
+	if text.Len() > 0 {
+		text.WriteString("\n")
+	}
+	text.WriteString("Constraints:\n")
+	for _, line := range lines {
+		text.WriteString("  ")
+		text.WriteString(line)
+	}
+	return text.String()
+}
+
+// plain returns the text of statement without its constraints.
+func plain(statement Statement, path string) string {
+	switch statement := statement.(type) {
+	case rule:
+		return ""
+	case _statements:
+		var text strings.Builder
+		for _, child := range statement.statements {
+			text.WriteString(plain(child, path))
+		}
+		return text.String()
+	}
+	return statement.String(path)
+}
+
+// rules returns the constraints of statement as lines of the usage message.
+func rules(statement Statement) []string {
+	switch statement := statement.(type) {
+	case rule:
+		return []string{sentence(statement.text)}
+	case _statements:
+		var lines []string
+		for _, child := range statement.statements {
+			lines = append(lines, rules(child)...)
+		}
+		return lines
+	}
+	return nil
+}
+
+// sentence returns rule as a line of the usage message.
+func sentence(rule string) string {
+	return strings.ToUpper(rule[:1]) + rule[1:] + ".\n"
+}
+
+// violation returns ParseError describing the violated rule.
+func violation(rule string, names []string) *ParseError {
+	err := parseError(ConstraintViolation, nil)
+	err.Names = names
+	err.Err = fmt.Errorf("%s", rule)
+	return err
+}
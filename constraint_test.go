@@ -0,0 +1,108 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConstraints(t *testing.T) {
+	nop := Function(func(ctx Context) error { return nil })
+	app := func(statement Statement) Application {
+		return Simple("deploy", "deploy services", statement,
+			Options(Option("s", "service", "service name", ""), Option("", "tag", "image tag", "")),
+			Flags(Flag("a", "all", "all services"), NegatableFlag("", "push", "push the image", true)),
+			Arguments(), NoVariadic(), nop)
+	}
+	tests := []struct {
+		statement Statement
+		args      []string
+		violated  string
+	}{
+		{ExactlyOneOf("all", "service"), []string{"deploy", "--all"}, ""},
+		{ExactlyOneOf("all", "service"), []string{"deploy", "-s", "api"}, ""},
+		{ExactlyOneOf("all", "service"), []string{"deploy"}, "Exactly one of (--all, --service)"},
+		{ExactlyOneOf("all", "service"), []string{"deploy", "-a", "-s", "api"}, "Exactly one of (--all, --service)"},
+		{AtMostOneOf("all", "service"), []string{"deploy"}, ""},
+		{AtMostOneOf("all", "service"), []string{"deploy", "--all", "--service=api"}, "At most one of (--all, --service)"},
+		{AllOrNone("service", "tag"), []string{"deploy"}, ""},
+		{AllOrNone("service", "tag"), []string{"deploy", "-s", "api", "--tag", "v1"}, ""},
+		{AllOrNone("service", "tag"), []string{"deploy", "--tag", "v1"}, "Either all or none of (--service, --tag)"},
+		{Requires("tag", "push"), []string{"deploy"}, ""},
+		{Requires("tag", "push"), []string{"deploy", "--tag", "v1", "--push"}, ""},
+		{Requires("tag", "push"), []string{"deploy", "--tag", "v1", "--no-push"}, "(--tag) requires (--push)"},
+		{Requires("tag", "push"), []string{"deploy", "--tag", "v1", "--push=false"}, "(--tag) requires (--push)"},
+		{Requires("tag", "push"), []string{"deploy", "--tag", "v1"}, "(--tag) requires (--push)"},
+		{Requires("push", "tag"), []string{"deploy", "--no-push"}, ""},
+		{Requires("push", "tag"), []string{"deploy", "--push"}, "(--push) requires (--tag)"},
+		{AtMostOneOf("all", "push"), []string{"deploy", "--all", "--no-push"}, "At most one of (--all, --push)"},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := Execute(app(test.statement), test.args, &stdout, &stderr)
+		if test.violated == "" && code != ExitSuccess {
+			t.Errorf("%v: exit code is %d, want %d (%s)", test.args, code, ExitSuccess, stderr.String())
+		}
+		if test.violated != "" && (code != ExitUsage || !strings.Contains(stderr.String(), test.violated)) {
+			t.Errorf("%v: exit code is %d and stderr is %q, want %d and %q", test.args, code, stderr.String(), ExitUsage, test.violated)
+		}
+	}
+}
+
+func TestConstraintsAreRenderedAsParagraph(t *testing.T) {
+	nop := Function(func(ctx Context) error { return nil })
+	statement := Statements(Text("Examples:", "  deploy --all"), Statements(ExactlyOneOf("all", "service")), Requires("tag", "all"))
+	app := Simple("deploy", "deploy services", statement,
+		Options(Option("s", "service", "service name", ""), Option("", "tag", "image tag", "")),
+		Flags(Flag("a", "all", "all services")), Arguments(), NoVariadic(), nop)
+	var stdout, stderr bytes.Buffer
+	Execute(app, []string{"deploy", "--help"}, &stdout, &stderr)
+	want := "Examples:\n  deploy --all\n\nConstraints:\n  Exactly one of (--all, --service) must be given.\n  (--tag) requires (--all) to be given.\n"
+	if !strings.HasSuffix(stdout.String(), want) {
+		t.Errorf("help does not end with %q:\n%s", want, stdout.String())
+	}
+}
+
+func TestConstraintsPanicOnUndeclaredNames(t *testing.T) {
+	nop := Function(func(ctx Context) error { return nil })
+	options := Options(Option("s", "service", "service name", ""))
+	flags := Flags(Flag("a", "all", "all services"))
+	statement := Statements(AtMostOneOf("all", "servcie"))
+	add := Command("add", "add", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nop)
+	constructors := map[string]func(){
+		"cli.Command": func() {
+			Command("deploy", "deploy", statement, options, flags, Arguments(), NoVariadic(), nop)
+		},
+		"cli.Parent": func() {
+			Parent("deploy", "deploy", statement, options, flags, add)
+		},
+		"cli.Nested": func() {
+			Nested("deploy", "deploy", statement, options, flags, Group("Commands", add))
+		},
+	}
+	for caller, construct := range constructors {
+		func() {
+			defer func() {
+				msg, _ := recover().(string)
+				if want := caller + ": constraint refers to unknown option or flag (servcie)"; msg != want {
+					t.Errorf("%s panics with %q, want %q", caller, msg, want)
+				}
+			}()
+			construct()
+		}()
+	}
+}
@@ -14,6 +14,10 @@
 
 package cli
 
+import (
+	"github.com/begopher/cli/internal/api"
+)
+
 // Context gives client of cli library (developer) the ability to access all options,
 // flags, arguments and variadic arguments' values, which has been passed by end user
 // of the cli application.
@@ -22,6 +26,14 @@ package cli
 //  - All Parents names if exist, followed by.
 //  - Command name.
 // 
+// Typed options (e.g. cli.IntOption, cli.DurationOption) are validated and converted
+// while parsing, their values can be accessed by cli.Get[T](context, key).
+//
+// Values of options and flags may be given on the command line, by environment variables
+// (see cli.Env), by the configuration file (see cli.Config) or set to the default value.
+// Origin and IsSet tell them apart, e.g. to find out why --region is eu, or whether the
+// end user explicitly passed a value identical to the default one.
+//
 // Option, Argument and Variadic methods return their values as strings. Therefore, parsing these values
 // in some cases to different data types like int or date might be necessity. In such cases,
 // if error occured due to invalid entry, "Context.Usage(...string) error" method can be used to
@@ -36,26 +48,61 @@ type Context interface {
 	// and returns true only if end user raised that flag either by the short name or by the long name.
 	Flag(string) bool
 	Flags() map[string]bool
+	// Count accepts either the short or the long name of any cli.CountFlag in the current
+	// executed command and returns how many times end user raised that flag. For other
+	// flags 1 is returned if the flag is raised, otherwise 0.
+	Count(string) int
 	// Option accepts either the short or the long name of any cli.Option in the current executed command
 	// and returns the assotiated value which has been given by end user. Otherwise the default will be returned.
 	Option(string) string
 	Options() map[string]string
+	// OptionValues accepts either the short or the long name of any cli.Option in the
+	// current executed command and returns all values given by end user, in the same
+	// order, for cli.RepeatableOption and cli.ListOption. For other options a slice of
+	// the single value is returned.
+	OptionValues(string) []string
+	// OptionMap accepts either the short or the long name of any cli.MapOption in the
+	// current executed command and returns all key=value entries given by end user.
+	OptionMap(string) map[string]string
+	// Value accepts either the short or the long name of any cli.Option or cli.Flag in
+	// the current executed command and returns its value, which is already converted
+	// to the type of the option (e.g. int for cli.IntOption), see cli.Get.
+	Value(string) any
 	// Argument accepts a name of any the cli.Argument in the current executed command and returns the correct value
 	// assosiated with that name, which has been given by the end user.
 	Argument(string) string
+	// ArgumentValue accepts a name of any cli.Argument in the current executed command and
+	// returns its value, which is already converted to the type of the argument (e.g. int
+	// for cli.IntArgument), see cli.GetArgument.
+	ArgumentValue(string) any
 	// Variadic returns slice of string of all additional values (that came after cli.Arguments) which has been given
 	// by the end user, if cli.NoVariadic is used instead of cli.Variadic then  empty slice will be returned.
 	Variadic() []string
+	// Origin accepts either the short or the long name of any cli.Option or cli.Flag in
+	// the current executed command and returns where its value came from (command line,
+	// environment variable, configuration file or default value).
+	Origin(string) Origin
+	// Origins returns where the values of all options and flags (of the executed command
+	// and its parents) came from, keyed by both short and long names.
+	Origins() map[string]Origin
+	// IsSet accepts either the short or the long name of any cli.Option or cli.Flag in
+	// the current executed command and reports whether its value is supplied by the end
+	// user (command line, environment variable or configuration file), even when it is
+	// identical to the default value. False is returned for default values and unknown names.
+	IsSet(string) bool
 	Path() []string
 	Usage(...string) error
 }
 
-func context(path []string, options map[string]string, flags map[string]bool, namedArgs map[string]string, variadicArgs []string, usage func(...string) error) _context {
+func context(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, namedArgs map[string]string, argValues map[string]any, variadicArgs []string, usage func(...string) error) _context {
 	return _context{
 		path:         path,
+		sources:      sources,
 		flags:        flags,
 		options:      options,
+		values:       values,
 		namedArgs:    namedArgs,
+		argValues:    argValues,
 		variadicArgs: variadicArgs,
 		//args:         args,
 		usage: usage,
@@ -64,9 +111,12 @@ func context(path []string, options map[string]string, flags map[string]bool, na
 
 type _context struct {
 	path         []string
+	sources      api.Sources
 	flags        map[string]bool
 	options      map[string]string
+	values       map[string]any
 	namedArgs    map[string]string
+	argValues    map[string]any
 	variadicArgs []string
 	//args         []string
 	usage func(summaries ...string) error
@@ -80,6 +130,16 @@ func (c _context) Flags() map[string]bool {
 	return c.flags
 }
 
+func (c _context) Count(name string) int {
+	if count, ok := c.values[name].(int); ok {
+		return count
+	}
+	if c.flags[name] {
+		return 1
+	}
+	return 0
+}
+
 func (c _context) Option(key string) string {
 	return c.options[key]
 }
@@ -88,10 +148,48 @@ func (c _context) Options() map[string]string {
 	return c.options
 }
 
+func (c _context) OptionValues(key string) []string {
+	if values, ok := c.values[key].([]string); ok {
+		return values
+	}
+	if value, ok := c.options[key]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (c _context) OptionMap(key string) map[string]string {
+	entries, _ := c.values[key].(map[string]string)
+	return entries
+}
+
+func (c _context) Value(key string) any {
+	if value, ok := c.values[key]; ok {
+		return value
+	}
+	if value, ok := c.options[key]; ok {
+		return value
+	}
+	if value, ok := c.flags[key]; ok {
+		return value
+	}
+	return nil
+}
+
 func (c _context) Argument(key string) string {
 	return c.namedArgs[key]
 }
 
+func (c _context) ArgumentValue(key string) any {
+	if value, ok := c.argValues[key]; ok {
+		return value
+	}
+	if value, ok := c.namedArgs[key]; ok {
+		return value
+	}
+	return nil
+}
+
 /*
 func (c _context) Arguments() []string {
 	return c.args
@@ -102,6 +200,25 @@ func (c _context) Variadic() []string {
 	return c.variadicArgs
 }
 
+func (c _context) Origin(name string) Origin {
+	return Origin(c.sources.Origin(name))
+}
+
+func (c _context) Origins() map[string]Origin {
+	origins := make(map[string]Origin, len(c.options)+len(c.flags))
+	for name := range c.options {
+		origins[name] = c.Origin(name)
+	}
+	for name := range c.flags {
+		origins[name] = c.Origin(name)
+	}
+	return origins
+}
+
+func (c _context) IsSet(name string) bool {
+	return c.Origin(name) != FromDefault
+}
+
 func (c _context) Path() []string {
 	return c.path
 }
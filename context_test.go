@@ -0,0 +1,60 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"testing"
+)
+
+func TestOrigins(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		env := Env("MYTOOL_")
+		return Simple("tool", "a tool", Statements(),
+			Options(env.Option("REGION", Option("r", "region", "deployment region", "eu"))),
+			Flags(env.Flag("DRY_RUN", Flag("n", "dry-run", "print only"))), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		env    map[string]string
+		args   []string
+		region Origin
+		dryRun Origin
+	}{
+		{nil, []string{"tool"}, FromDefault, FromDefault},
+		{nil, []string{"tool", "--region", "eu"}, FromCommandLine, FromDefault},
+		{nil, []string{"tool", "-r", "us", "-n"}, FromCommandLine, FromCommandLine},
+		{map[string]string{"MYTOOL_REGION": "eu", "MYTOOL_DRY_RUN": "false"}, []string{"tool"}, FromEnv, FromEnv},
+		{map[string]string{"MYTOOL_REGION": "us"}, []string{"tool", "--region=eu"}, FromCommandLine, FromDefault},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, test.env, test.args...)
+		if failed(t, test.args, parse, Unspecified, "", 0) {
+			continue
+		}
+		if region, dryRun := ctx.Origin("region"), ctx.Origin("n"); region != test.region || dryRun != test.dryRun {
+			t.Errorf("%v %v: origins are %s and %s, want %s and %s", test.env, test.args, region, dryRun, test.region, test.dryRun)
+		}
+		if set := ctx.IsSet("r"); set != (test.region != FromDefault) {
+			t.Errorf("%v %v: region is set %v, want %v", test.env, test.args, set, !set)
+		}
+		origins := ctx.Origins()
+		if len(origins) != 4 || origins["r"] != test.region || origins["region"] != test.region || origins["dry-run"] != test.dryRun {
+			t.Errorf("%v %v: unexpected origins %v", test.env, test.args, origins)
+		}
+	}
+	ctx, _ := invoke(t, app, nil, "tool")
+	if ctx.IsSet("unknown") || ctx.Origin("unknown") != FromDefault {
+		t.Errorf("unknown name is set")
+	}
+}
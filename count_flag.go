@@ -0,0 +1,117 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// CountFlag represents a Flag which counts how many times it is raised by the
+// end user, e.g. -v -v, -vv and --verbose --verbose all count to 2, see cli.Flag.
+// It is meant to drive levels such as verbosity without separate flags.
+//
+// The count can be accessed by cli.Context.Count("key"), while cli.Context.Flag("key")
+// returns true when the flag is raised at least once.
+//
+// # Panic when:
+//   - see cli.Flag.
+func CountFlag(sname, lname, description string) countFlag {
+	return countFlag{
+		flag: Flag(sname, lname, description),
+	}
+}
+
+type countFlag struct {
+	flag
+}
+
+func (f countFlag) Extract(flags map[string]bool, values map[string]any, args []string) ([]string, error) {
+	raised := f.occurrences(args)
+	args, err := f.flag.Extract(flags, values, args)
+	if err != nil {
+		return args, err
+	}
+	if raised > 0 {
+		count, _ := values[f.key()].(int)
+		f.store(values, count+raised)
+	}
+	return args, nil
+}
+
+func (f countFlag) Kind() api.Kind {
+	return api.Kind{Count: true}
+}
+
+// occurrences returns how many times the flag is raised by args[0], which is at
+// most once as grouped flags are consumed one by one.
+func (f countFlag) occurrences(args []string) int {
+	if len(args) < 1 {
+		return 0
+	}
+	if args[0] == "-" || args[0] == "--" || strings.HasPrefix(args[0], "---") {
+		return 0
+	}
+	if key, ok := strings.CutPrefix(args[0], "--"); ok {
+		if f.lname != "" && key == f.lname {
+			return 1
+		}
+		return 0
+	}
+	if key, ok := strings.CutPrefix(args[0], "-"); ok && f.sname != "" && strings.HasPrefix(key, f.sname) {
+		return 1 // grouped flags are consumed one letter at a time, see flag.Extract
+	}
+	return 0
+}
+
+func (f countFlag) Default(flags map[string]bool, values map[string]any) {
+	f.flag.Default(flags, values)
+	if _, ok := values[f.key()]; !ok {
+		f.store(values, 0)
+	}
+}
+
+// assign sets the count from an environment variable or a config file, where
+// value is either a count (e.g. 3) or a boolean (true counts as 1).
+func (f countFlag) assign(to map[string]bool, values map[string]any, value string) error {
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		var raised bool
+		raised, err = strconv.ParseBool(value)
+		count = 0
+		if raised {
+			count = 1
+		}
+	}
+	if err != nil || count < 0 {
+		parse := parseError(InvalidValue, []string{value})
+		parse.Err = errors.New("non-negative integer or boolean is expected")
+		return parse
+	}
+	for _, key := range []string{f.sname, f.lname} {
+		if key != "" {
+			to[key] = count > 0
+		}
+	}
+	f.store(values, count)
+	return nil
+}
+
+func (f countFlag) String(width int) string {
+	return f.render(width, f.Label(), "(repeatable)")
+}
@@ -0,0 +1,44 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Decoder decodes the content of a configuration file (see cli.Config) into nested
+// objects, where values are strings, numbers, booleans, arrays or objects e.g. what
+// encoding/json produces when decoding into map[string]any.
+type Decoder interface {
+	Decode(data []byte) (map[string]any, error)
+}
+
+// JSON returns Decoder of JSON configuration files.
+func JSON() Decoder {
+	return jsonDecoder{}
+}
+
+type jsonDecoder struct{}
+
+func (jsonDecoder) Decode(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var config map[string]any
+	if err := decoder.Decode(&config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -0,0 +1,64 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// Completer returns the completion candidates of an option value or an argument,
+// where ctx holds the values parsed so far (including default values) and partial
+// is the word being completed. A candidate may be followed by a tab and its
+// description e.g. "main\tdefault branch". Candidates not starting with partial
+// are dropped, see cli.Completed and cli.CompletedArgument.
+type Completer func(ctx Context, partial string) ([]string, Directive)
+
+// completer adapts Completer to api.Completer, see cli.Completed.
+type completer struct {
+	complete Completer
+}
+
+// Complete calls the completer with the context of the values parsed so far, it is
+// called by the application when the end user presses tab.
+func (c completer) Complete(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, named map[string]string, arguments map[string]any, variadic []string, partial string) ([]string, int) {
+	report := func(summaries ...string) error {
+		msg := strings.Join(summaries, "\n")
+		return usageError(path, Unspecified, "", errors.New(msg), "")
+	}
+	ctx := context(path, sources, options, flags, values, named, arguments, variadic, report)
+	candidates, directive := c.complete(ctx, partial)
+	return candidates, int(directive)
+}
+
+// Directive tells the shell how to treat completion candidates, directives can be
+// combined e.g. cli.CompleteNoSpace | cli.CompleteNoFiles.
+type Directive int
+
+const (
+	// CompleteDefault lets the shell complete file names when there are no candidates.
+	CompleteDefault Directive = 0
+	// CompleteNoSpace prevents the shell from adding a space after the candidate.
+	CompleteNoSpace Directive = 1 << (iota - 1)
+	// CompleteNoFiles prevents the shell from completing file names.
+	CompleteNoFiles
+	// CompleteFiles makes the shell complete file names when there are no candidates.
+	CompleteFiles
+	// CompleteDirectories makes the shell complete directory names only when there
+	// are no candidates.
+	CompleteDirectories
+)
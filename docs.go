@@ -0,0 +1,112 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"strings"
+)
+
+// FrontMatter returns the text which is injected into the documentation page of
+// the given command path e.g. YAML front matter of a static site generator, see
+// cli.MarkdownDocs and cli.HTMLDocs.
+type FrontMatter func(path []string, description string) string
+
+// MarkdownDocs returns a Markdown page for every command path of app, keyed by
+// file name. The page of the application itself is index.md, it lists the
+// commands of every cli.Group section, while the other pages are named after
+// their command path joined by hyphen e.g. git-remote-add.md. Pages link to
+// their parent and sub commands relatively so they can be placed in any directory.
+//
+// Every page consists of the command path as title, the description, the usage
+// line, the sub commands, options, flags and arguments, followed by the statement
+// text of the command, where a paragraph starting with a line such as "Examples:"
+// becomes a section of that title. When frontMatter is not nil, its result is
+// written at the top of every page e.g.
+//
+//	cli.MarkdownDocs(app, func(path []string, description string) string {
+//		return fmt.Sprintf("---\ntitle: %s\n---\n", strings.Join(path, " "))
+//	})
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+func MarkdownDocs(app Application, frontMatter FrontMatter) map[string]string {
+	root := runnable("cli.MarkdownDocs", app).tree()
+	return docs(root, ".md", frontMatter, func() writer { return &markdown{extension: ".md"} })
+}
+
+// HTMLDocs returns a standalone HTML page for every command path of app, keyed by
+// file name, the pages are identical to what cli.MarkdownDocs returns except that
+// their names end with .html and the result of frontMatter (if not nil) is written
+// into the head element e.g. meta and link elements.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+func HTMLDocs(app Application, frontMatter FrontMatter) map[string]string {
+	root := runnable("cli.HTMLDocs", app).tree()
+	return docs(root, ".html", frontMatter, func() writer { return &hypertext{extension: ".html"} })
+}
+
+// writer renders the elements of a documentation page, see docs.
+type writer interface {
+	begin(title, frontMatter string)
+	heading(text string)
+	paragraph(text string)
+	code(lines []string)
+	list(items []item)
+	end() string
+}
+
+// docFile returns the file name of the documentation page of path.
+func docFile(path []string, extension string) string {
+	if len(path) == 1 {
+		return "index" + extension
+	}
+	return strings.Join(path, "-") + extension
+}
+
+func docs(root node, extension string, frontMatter FrontMatter, create func() writer) map[string]string {
+	pages := make(map[string]string)
+	var visit func(n node, parent []string)
+	visit = func(n node, parent []string) {
+		w := create()
+		var text string
+		if frontMatter != nil {
+			text = frontMatter(n.path, n.description)
+		}
+		w.begin(n.fullPath(), text)
+		w.paragraph(n.description)
+		w.heading("Usage")
+		w.code([]string{n.synopsis()})
+		for _, list := range n.lists() {
+			w.heading(list.title)
+			w.list(list.items)
+		}
+		for _, topic := range n.topics() {
+			w.heading(topic.title)
+			w.code(topic.lines)
+		}
+		related := n.related(parent)
+		if len(related) > 0 {
+			w.heading("See also")
+			w.list(related)
+		}
+		pages[docFile(n.path, extension)] = w.end()
+		for _, child := range n.children() {
+			visit(child, n.path)
+		}
+	}
+	visit(root, nil)
+	return pages
+}
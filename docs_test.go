@@ -0,0 +1,70 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// matter is the front matter of the documentation pages of the tests.
+func matter(path []string, description string) string {
+	return fmt.Sprintf("---\ntitle: %s\n---", strings.Join(path, " "))
+}
+
+func TestMarkdownDocs(t *testing.T) {
+	golden(t, "markdown.txt", joined(MarkdownDocs(fixture(), matter)))
+}
+
+func TestHTMLDocs(t *testing.T) {
+	golden(t, "html.txt", joined(HTMLDocs(fixture(), nil)))
+}
+
+func TestCodeSpan(t *testing.T) {
+	tests := map[string]string{
+		"--output=VALUE": "`--output=VALUE`",
+		"a`b":            "``a`b``",
+		"a``b`":          "``` a``b` ```",
+		"`":              "`` ` ``",
+	}
+	for text, want := range tests {
+		if got := codeSpan(text); got != want {
+			t.Errorf("codeSpan(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestNegatableFlagDefaultInDocs(t *testing.T) {
+	nothing := Function(func(Context) error { return nil })
+	app := Simple("tool", "A tool.", Statements(), Options(),
+		Flags(NegatableFlag("", "color", "colorize the output", true), NegatableFlag("", "cache", "use the cache", false)),
+		Arguments(), NoVariadic(), nothing)
+	tests := []struct {
+		format string
+		pages  map[string]string
+		raised string
+		plain  string
+	}{
+		{"markdown", MarkdownDocs(app, nil), "- `--[no-]color`: colorize the output (Default: true)\n", "- `--[no-]cache`: use the cache\n"},
+		{"html", HTMLDocs(app, nil), "<li><code>--[no-]color</code>: colorize the output (Default: true)</li>\n", "<li><code>--[no-]cache</code>: use the cache</li>\n"},
+	}
+	for _, test := range tests {
+		text := joined(test.pages)
+		if !strings.Contains(text, test.raised) || !strings.Contains(text, test.plain) {
+			t.Errorf("%s: defaults of negatable flags are not noted as expected:\n%s", test.format, text)
+		}
+	}
+}
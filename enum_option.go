@@ -0,0 +1,93 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnumOption represents an Option which accepts only one of the given choices
+// (e.g. --format json|yaml|table), see cli.Option.
+//
+// Value outside choices is reported as a usage error listing the choices, which
+// are also printed in the Options section. value is the default, it can be empty.
+//
+// # Panic when:
+//   - choices is empty, or has an empty or a duplicated choice.
+//   - value is not empty and not one of the choices.
+//   - see cli.Option.
+func EnumOption(sname, lname, description string, choices []string, value string) enumOption {
+	if len(choices) == 0 {
+		panic("cli.EnumOption: choices cannot be empty")
+	}
+	namespace := namespace()
+	for _, choice := range choices {
+		if choice == "" {
+			panic("cli.EnumOption: empty choice is not allowed")
+		}
+		if err := namespace.Add(choice); err != nil {
+			msg := fmt.Sprintf("cli.EnumOption: choice (%s) is duplicated", choice)
+			panic(msg)
+		}
+	}
+	if value != "" && !contains(choices, value) {
+		msg := fmt.Sprintf("cli.EnumOption: value (%s) is not one of the choices", value)
+		panic(msg)
+	}
+	return enumOption{
+		option:  Option(sname, lname, description, value),
+		choices: append([]string(nil), choices...),
+	}
+}
+
+type enumOption struct {
+	option
+	choices []string
+}
+
+func (o enumOption) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	name, text, rest, ok := o.match(args)
+	if !ok {
+		return args, nil
+	}
+	if !contains(o.choices, text) {
+		err := fmt.Errorf("one of (%s) is expected", strings.Join(o.choices, ", "))
+		return args, valueError(InvalidValue, args, rest, name, text, err)
+	}
+	o.set(opts, text)
+	return rest, nil
+}
+
+func (o enumOption) Choices() []string {
+	return o.choices
+}
+
+func (o enumOption) String(width int) string {
+	note := fmt.Sprintf("{%s}", strings.Join(o.choices, "|"))
+	if o.value != "" {
+		note = fmt.Sprintf("%s (%s)", note, o.value)
+	}
+	return o.render(width, o.Label(), note)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
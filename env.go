@@ -0,0 +1,125 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// Env binds options and flags to environment variables whose names start with
+// prefix (e.g. MYTOOL_), prefix may be empty. The value of a bound environment
+// variable is used when the option or flag is not given on the command line,
+// before falling back to the configuration file (see cli.Config) and then to
+// the default value (argv > env > config > default).
+//
+//	env := cli.Env("MYTOOL_")
+//	cli.Options(env.Option("REGION", cli.Option("r", "region", "deployment region", "us")))
+//	cli.Flags(env.Flag("VERBOSE", cli.Flag("v", "verbose", "print more details")))
+//
+// The value is interpreted exactly as if it was given on the command line e.g.
+// MYTOOL_REGION=eu is identical to --region=eu. Flags accept boolean values
+// (1, t, true, 0, f, false ...).
+//
+// Environment variables are read by os.LookupEnv, cli.Environment can be used
+// to supply a different environment e.g. in tests.
+func Env(prefix string) env {
+	prefix = strings.TrimSpace(prefix)
+	if strings.ContainsAny(prefix, "= ") {
+		panic("cli.Env: prefix cannot contain = or space")
+	}
+	return env{
+		prefix: prefix,
+	}
+}
+
+type env struct {
+	prefix string
+}
+
+// Option binds option to the environment variable prefix+name.
+//
+// # Panic when:
+//   - name is empty or contains = or space.
+//   - option is nil.
+func (e env) Option(name string, option api.Option) envOption {
+	if option == nil {
+		panic("cli.Env: option cannot be nil")
+	}
+	return envOption{
+		Option: option,
+		name:   e.name(name),
+	}
+}
+
+// Flag binds flag to the environment variable prefix+name.
+//
+// # Panic when:
+//   - name is empty or contains = or space.
+//   - flag is nil.
+func (e env) Flag(name string, flag api.Flag) envFlag {
+	if flag == nil {
+		panic("cli.Env: flag cannot be nil")
+	}
+	return envFlag{
+		Flag: flag,
+		name: e.name(name),
+	}
+}
+
+func (e env) name(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		panic("cli.Env: name cannot be empty")
+	}
+	if strings.ContainsAny(name, "= ") {
+		panic("cli.Env: name cannot contain = or space")
+	}
+	return e.prefix + name
+}
+
+type envOption struct {
+	api.Option
+	name string
+}
+
+func (e envOption) Env() string {
+	return e.name
+}
+
+func (e envOption) String(width int) string {
+	line := strings.TrimRight(e.Option.String(width), " \n")
+	return line + " [$" + e.name + "]\n"
+}
+
+type envFlag struct {
+	api.Flag
+	name string
+}
+
+func (e envFlag) Env() string {
+	return e.name
+}
+
+// assign forwards value to the bound flag, see assigner.
+func (e envFlag) assign(to map[string]bool, values map[string]any, value string) error {
+	return raise(e.Flag, to, values, value)
+}
+
+func (e envFlag) String(width int) string {
+	line := strings.TrimRight(e.Flag.String(width), " \n")
+	return line + " [$" + e.name + "]\n"
+}
@@ -0,0 +1,62 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+// Environment returns app which reads environment variables (see cli.Env)
+// by lookup instead of os.LookupEnv. It is meant for tests, where a fake
+// environment can be given without touching the process environment.
+//
+//	fake := map[string]string{"MYTOOL_REGION": "eu"}
+//	app = cli.Environment(app, func(name string) (string, bool) {
+//		value, ok := fake[name]
+//		return value, ok
+//	})
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+//   - lookup is nil.
+func Environment(app Application, lookup func(string) (string, bool)) Application {
+	runner := runnable("cli.Environment", app)
+	if lookup == nil {
+		panic("cli.Environment: lookup cannot be nil")
+	}
+	return environment{
+		app:    runner,
+		lookup: lookup,
+	}
+}
+
+type environment struct {
+	app    runner
+	lookup func(string) (string, bool)
+}
+
+func (e environment) Run(args []string) error {
+	return e.start(args, sources())
+}
+
+func (e environment) builtin(name, description string) runner {
+	e.app = e.app.builtin(name, description)
+	return e
+}
+
+func (e environment) tree() node {
+	return e.app.tree()
+}
+
+func (e environment) start(args []string, sources _sources) error {
+	sources.env = e.lookup
+	return e.app.start(args, sources)
+}
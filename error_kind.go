@@ -0,0 +1,76 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+// ErrorKind classifies a usage error, so callers can react to a particular
+// mistake of the end user without matching the text of the usage message.
+type ErrorKind int
+
+const (
+	// Unspecified is the kind of usage errors reported by an Implementation
+	// through cli.Context.Usage(...string) or cli.Error(context, error).
+	Unspecified ErrorKind = iota
+	UnknownOption
+	UnknownFlag
+	UnknownOptionOrFlag
+	MissingOptionValue
+	MissingArgument
+	UnexpectedValue
+	MissingDoubleDash
+	UnknownCommand
+	NoCommandSelected
+	InvalidValue
+	TooManyValues
+	TooFewValues
+	DuplicateKey
+	MissingOption
+	ConstraintViolation
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case UnknownOption:
+		return "UnknownOption"
+	case UnknownFlag:
+		return "UnknownFlag"
+	case UnknownOptionOrFlag:
+		return "UnknownOptionOrFlag"
+	case MissingOptionValue:
+		return "MissingOptionValue"
+	case MissingArgument:
+		return "MissingArgument"
+	case UnexpectedValue:
+		return "UnexpectedValue"
+	case MissingDoubleDash:
+		return "MissingDoubleDash"
+	case UnknownCommand:
+		return "UnknownCommand"
+	case NoCommandSelected:
+		return "NoCommandSelected"
+	case InvalidValue:
+		return "InvalidValue"
+	case TooManyValues:
+		return "TooManyValues"
+	case TooFewValues:
+		return "TooFewValues"
+	case DuplicateKey:
+		return "DuplicateKey"
+	case MissingOption:
+		return "MissingOption"
+	case ConstraintViolation:
+		return "ConstraintViolation"
+	}
+	return "Unspecified"
+}
@@ -0,0 +1,40 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+)
+
+// ExactlyOneOf is a Statement which requires the end user to set exactly one
+// of the named options/flags e.g. (deploy --all | --service NAME). Violation is
+// reported as a usage error, and the rule is printed at the end of the usage message.
+// Values taken from environment variables or config files (see cli.Env and cli.Config)
+// are counted as given, while default values are not.
+//
+// # Panic when:
+//   - less than two names are given, or a name is empty or duplicated.
+//   - a name is not an option/flag of the command (checked by cli.Command,
+//     cli.Parent and cli.Nested).
+func ExactlyOneOf(names ...string) rule {
+	names = constrained("cli.ExactlyOneOf", 2, names)
+	return rule{
+		names: names,
+		text:  fmt.Sprintf("exactly one of %s must be given", hyphenate(names)),
+		violated: func(given map[string]bool) bool {
+			return count(given, names) != 1
+		},
+	}
+}
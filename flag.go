@@ -17,6 +17,8 @@ package cli
 import (
 	"fmt"
 	"strings"
+
+	"github.com/begopher/cli/internal/api"
 )
 
 // Flag represents a special kind of a command line option which hold a boolean value,
@@ -80,12 +82,12 @@ type flag struct {
 	description string
 }
 
-func (f flag) Extract(opts map[string]bool, args []string) []string {
+func (f flag) Extract(opts map[string]bool, values map[string]any, args []string) ([]string, error) {
 	if len(args) < 1 {
-		return args
+		return args, nil
 	}
 	if args[0] == "-" || args[0] == "--" || strings.HasPrefix(args[0], "---") {
-		return args
+		return args, nil
 	}
 	if key, ok := strings.CutPrefix(args[0], "--"); ok {
 		if key == f.lname {
@@ -93,9 +95,9 @@ func (f flag) Extract(opts map[string]bool, args []string) []string {
 			if f.sname != "" {
 				opts[f.sname] = true
 			}
-			return args[1:]
+			return args[1:], nil
 		}
-		return args
+		return args, nil
 	}
 	if key, ok := strings.CutPrefix(args[0], "-"); ok {
 		if key == f.sname {
@@ -103,25 +105,27 @@ func (f flag) Extract(opts map[string]bool, args []string) []string {
 			if f.lname != "" {
 				opts[f.lname] = true
 			}
-			return args[1:]
+			return args[1:], nil
 		}
-		newKey := strings.ReplaceAll(key, f.sname, "")
-		if key == newKey {
-			return args
+		// grouped flags (e.g. -vxf) are read from left to right, one letter at a time,
+		// so letters following an option (e.g. -vovalue) remain its value
+		rest, ok := strings.CutPrefix(key, f.sname)
+		if !ok || f.sname == "" {
+			return args, nil
 		}
 		opts[f.sname] = true
 		if f.lname != "" {
 			opts[f.lname] = true
 		}
-		if newKey == "" {
-			return args[1:]
-		}
-		args[0] = fmt.Sprintf("-%s", newKey)
+		grouped := make([]string, len(args))
+		copy(grouped, args)
+		grouped[0] = fmt.Sprintf("-%s", rest)
+		return grouped, nil
 	}
-	return args
+	return args, nil
 }
 
-func (f flag) Default(flags map[string]bool) {
+func (f flag) Default(flags map[string]bool, values map[string]any) {
 	_, ok := flags[f.lname]
 	if f.lname != "" && !ok {
 		flags[f.lname] = false
@@ -132,6 +136,24 @@ func (f flag) Default(flags map[string]bool) {
 	}
 }
 
+// store saves the converted value of the flag in values under both names.
+func (f flag) store(values map[string]any, value any) {
+	if f.lname != "" {
+		values[f.lname] = value
+	}
+	if f.sname != "" {
+		values[f.sname] = value
+	}
+}
+
+// key returns the name which is used to look up the flag's value.
+func (f flag) key() string {
+	if f.lname != "" {
+		return f.lname
+	}
+	return f.sname
+}
+
 func (f flag) SName() string {
 	return f.sname
 }
@@ -140,19 +162,47 @@ func (f flag) LName() string {
 	return f.lname
 }
 
+func (f flag) Description() string {
+	return f.description
+}
+
+func (f flag) Kind() api.Kind {
+	return api.Kind{}
+}
+
+func (f flag) Value() string {
+	return ""
+}
+
+func (f flag) Env() string {
+	return ""
+}
+
+func (f flag) Label() string {
+	if f.lname == "" {
+		return ""
+	}
+	return "--" + f.lname
+}
+
 func (f flag) String(width int) string {
+	return f.render(width, f.Label(), "")
+}
+
+// render returns the line of the flag in Flags section, where label is printed
+// in the long name column, and note (if any) is printed right after the description.
+func (f flag) render(width int, label, note string) string {
 	prefix := "  "
 	sflag := "    " // three spaces
 	if f.sname != "" {
 		sflag = "-" + f.sname + ", "
 	}
 
-	lflag := "  "
-	if f.lname != "" {
-		lflag = "--"
+	lflag := fmt.Sprintf("%-[1]*s", width, label)
+	description := f.description
+	if note != "" {
+		description = fmt.Sprintf("%s %s", description, note)
 	}
-
-	lflag = fmt.Sprintf("%s%-[2]*s", lflag, width, f.lname)
 	msg := "%s%s%s  %s\n"
-	return fmt.Sprintf(msg, prefix, sflag, lflag, f.description)
+	return fmt.Sprintf(msg, prefix, sflag, lflag, description)
 }
@@ -0,0 +1,98 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"testing"
+)
+
+func TestCountFlags(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(Option("o", "output", "output file", "")),
+			Flags(CountFlag("v", "verbose", "verbose output"), CountFlag("c", "color", "color level")),
+			Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		verbose  int
+		color    int
+		output   string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, 0, 0, "", Unspecified, "", 0},
+		{[]string{"tool", "-v"}, 1, 0, "", Unspecified, "", 0},
+		{[]string{"tool", "-vv"}, 2, 0, "", Unspecified, "", 0},
+		{[]string{"tool", "-v", "-v"}, 2, 0, "", Unspecified, "", 0},
+		{[]string{"tool", "--verbose", "--verbose", "-v"}, 3, 0, "", Unspecified, "", 0},
+		{[]string{"tool", "-vcvc"}, 2, 2, "", Unspecified, "", 0},
+		{[]string{"tool", "-voclock"}, 1, 0, "clock", Unspecified, "", 0},
+		{[]string{"tool", "-ccoc"}, 0, 2, "c", Unspecified, "", 0},
+		{[]string{"tool", "-vco", "vc"}, 1, 1, "vc", Unspecified, "", 0},
+		{[]string{"tool", "-vco"}, 0, 0, "", MissingOptionValue, "-o", 1},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if verbose, color := ctx.Count("verbose"), ctx.Count("c"); verbose != test.verbose || color != test.color {
+			t.Errorf("%v: counts are %d and %d, want %d and %d", test.args, verbose, color, test.verbose, test.color)
+		}
+		if ctx.Flag("v") != (test.verbose > 0) {
+			t.Errorf("%v: flag is %v, want %v", test.args, ctx.Flag("v"), test.verbose > 0)
+		}
+		if output := ctx.Option("output"); output != test.output {
+			t.Errorf("%v: output is %q, want %q", test.args, output, test.output)
+		}
+	}
+}
+
+func TestNegatableFlags(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(),
+			Flags(NegatableFlag("p", "push", "push the images", true), NegatableFlag("", "cache", "use the cache", false)),
+			Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		push     bool
+		cache    bool
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, true, false, Unspecified, "", 0},
+		{[]string{"tool", "--no-push", "--cache"}, false, true, Unspecified, "", 0},
+		{[]string{"tool", "--push=false", "--cache=1"}, false, true, Unspecified, "", 0},
+		{[]string{"tool", "--no-push", "-p"}, true, false, Unspecified, "", 0},
+		{[]string{"tool", "--cache", "--no-cache"}, true, false, Unspecified, "", 0},
+		{[]string{"tool", "--push=maybe"}, false, false, InvalidValue, "maybe", 1},
+		{[]string{"tool", "--no-p"}, false, false, UnknownFlag, "--no-p", 1},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if push, cache := ctx.Flag("push"), ctx.Flag("cache"); push != test.push || cache != test.cache {
+			t.Errorf("%v: push is %v and cache is %v, want %v and %v", test.args, push, cache, test.push, test.cache)
+		}
+		if ctx.Flag("p") != ctx.Flag("push") {
+			t.Errorf("%v: short name has %v, want %v", test.args, ctx.Flag("p"), ctx.Flag("push"))
+		}
+	}
+}
@@ -47,8 +47,8 @@ func Flags(flgs ...api.Flag) flags {
 			msg := fmt.Sprintf("cli.Flags: %s is duplicated", flag.LName())
 			panic(msg)
 		}
-		if width < len(name) {
-			width = len(name)
+		if label := flag.Label(); width < len(label) {
+			width = len(label)
 		}
 	}
 	return flags{
@@ -62,27 +62,39 @@ type flags struct {
 	width int
 }
 
-func (f flags) Extract(to map[string]bool, args []string) []string {
-	args = f.recursive(to, args)
-	return args
+func (f flags) Extract(to map[string]bool, values map[string]any, args []string) ([]string, error) {
+	return f.recursive(to, values, args)
+}
 
+func (f flags) Default(to map[string]bool, values map[string]any) {
+	for _, flag := range f.flgs {
+		flag.Default(to, values)
+	}
 }
 
-func (f flags) Default(to map[string]bool) {
+// Resolve sets the flags which are not given on the command line from sources,
+// see cli.Env and cli.Config.
+func (f flags) Resolve(path []string, sources api.Sources, to map[string]bool, values map[string]any) error {
 	for _, flag := range f.flgs {
-		flag.Default(to)
+		if err := resolveFlag(path, sources, flag, to, values); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (f flags) recursive(to map[string]bool, args []string) []string {
+func (f flags) recursive(to map[string]bool, values map[string]any, args []string) ([]string, error) {
 	length := len(args)
 	for _, flag := range f.flgs {
-		args = flag.Extract(to, args)
+		var err error
+		if args, err = flag.Extract(to, values, args); err != nil {
+			return args, err
+		}
 		if length != len(args) {
-			break
+			return args, nil
 		}
 	}
-	return args
+	return args, nil
 }
 
 func (f flags) Names() []string {
@@ -100,6 +112,10 @@ func (f flags) Names() []string {
 	return names
 }
 
+func (f flags) List() []api.Flag {
+	return f.flgs
+}
+
 func (f flags) Count() int {
 	return len(f.flgs)
 }
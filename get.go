@@ -0,0 +1,65 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+)
+
+// Get returns the value of the option or flag named key from the context, converted
+// to T, which must match the type of the option e.g. int for cli.IntOption, string
+// for cli.Option and bool for cli.Flag. The zero value of T is returned when the
+// current executed command has no option or flag named key.
+//
+//	timeout := cli.Get[time.Duration](ctx, "timeout")
+//
+// # Panic when:
+//   - T does not match the type of the option or flag.
+func Get[T any](ctx Context, key string) T {
+	var zero T
+	value := ctx.Value(key)
+	if value == nil {
+		return zero
+	}
+	converted, ok := value.(T)
+	if !ok {
+		msg := fmt.Sprintf("cli.Get: value of (%s) is %T not %T", key, value, zero)
+		panic(msg)
+	}
+	return converted
+}
+
+// GetArgument returns the value of the argument named name from the context, converted
+// to T, which must match the type of the argument e.g. int for cli.IntArgument, *url.URL
+// for cli.URLArgument and string for cli.Argument. The zero value of T is returned when
+// the current executed command has no argument named name.
+//
+//	port := cli.GetArgument[int](ctx, "PORT")
+//
+// # Panic when:
+//   - T does not match the type of the argument.
+func GetArgument[T any](ctx Context, name string) T {
+	var zero T
+	value := ctx.ArgumentValue(name)
+	if value == nil {
+		return zero
+	}
+	converted, ok := value.(T)
+	if !ok {
+		msg := fmt.Sprintf("cli.GetArgument: value of (%s) is %T not %T", name, value, zero)
+		panic(msg)
+	}
+	return converted
+}
@@ -44,8 +44,8 @@ type group struct {
 	commands api.Commands
 }
 
-func (g group) Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error) {
-	ok, err := g.commands.Exec(path, options, flags, args)
+func (g group) Exec(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error) {
+	ok, err := g.commands.Exec(path, sources, options, flags, values, args)
 	if err != nil {
 		return ok, err
 	}
@@ -63,6 +63,10 @@ func (g group) Names() []string {
 	return g.commands.Names()
 }
 
+func (g group) Commands() []api.Command {
+	return g.commands.List()
+}
+
 func (g group) Namespace() api.Namespace {
 	return g.commands.Namespace()
 }
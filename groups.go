@@ -52,9 +52,9 @@ type _groups struct {
 	namespace api.Namespace
 }
 
-func (g _groups) Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error) {
+func (g _groups) Exec(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error) {
 	for _, group := range g.grps {
-		ok, err := group.Exec(path, options, flags, args)
+		ok, err := group.Exec(path, sources, options, flags, values, args)
 		if err != nil {
 			return ok, err
 		}
@@ -65,6 +65,10 @@ func (g _groups) Exec(path []string, options map[string]string, flags map[string
 	return false, nil
 }
 
+func (g _groups) List() []api.Group {
+	return g.grps
+}
+
 func (g _groups) String() string {
 	var text strings.Builder
 	for _, group := range g.grps {
@@ -0,0 +1,42 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+// HelpRequested is returned by Application.Run when the end user asked for
+// the usage message (--help) instead of executing a command. It is not a
+// failure, the usage message should be printed to the standard output and
+// the application should exit with status 0.
+//
+//	var help cli.HelpRequested
+//	if errors.As(err, &help) {
+//		fmt.Print(help.Usage)
+//	}
+type HelpRequested struct {
+	// Path is the path of the command which its usage was requested.
+	Path []string
+	// Usage is the rendered usage message.
+	Usage string
+}
+
+func (h HelpRequested) Error() string {
+	return h.Usage
+}
+
+func helpRequested(path []string, usage string) HelpRequested {
+	return HelpRequested{
+		Path:  clonePath(path),
+		Usage: usage,
+	}
+}
@@ -0,0 +1,81 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// hypertext is the writer of cli.HTMLDocs.
+type hypertext struct {
+	extension string
+	text      strings.Builder
+}
+
+func (h *hypertext) begin(title, frontMatter string) {
+	h.text.WriteString("<!DOCTYPE html>\n")
+	h.text.WriteString("<html>\n")
+	h.text.WriteString("<head>\n")
+	h.text.WriteString("<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&h.text, "<title>%s</title>\n", html.EscapeString(title))
+	if frontMatter != "" {
+		h.text.WriteString(frontMatter)
+		if !strings.HasSuffix(frontMatter, "\n") {
+			h.text.WriteString("\n")
+		}
+	}
+	h.text.WriteString("</head>\n")
+	h.text.WriteString("<body>\n")
+	fmt.Fprintf(&h.text, "<h1>%s</h1>\n", html.EscapeString(title))
+}
+
+func (h *hypertext) heading(text string) {
+	fmt.Fprintf(&h.text, "<h2>%s</h2>\n", html.EscapeString(text))
+}
+
+func (h *hypertext) paragraph(text string) {
+	fmt.Fprintf(&h.text, "<p>%s</p>\n", html.EscapeString(text))
+}
+
+func (h *hypertext) code(lines []string) {
+	fmt.Fprintf(&h.text, "<pre><code>%s</code></pre>\n", html.EscapeString(strings.Join(lines, "\n")))
+}
+
+func (h *hypertext) list(items []item) {
+	h.text.WriteString("<ul>\n")
+	for _, item := range items {
+		term := "<code>" + html.EscapeString(item.term) + "</code>"
+		if item.path != nil {
+			term = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(docFile(item.path, h.extension)), term)
+		}
+		fmt.Fprintf(&h.text, "<li>%s", term)
+		if item.description != "" {
+			fmt.Fprintf(&h.text, ": %s", html.EscapeString(item.description))
+		}
+		if len(item.notes) > 0 {
+			fmt.Fprintf(&h.text, " (%s)", html.EscapeString(strings.Join(item.notes, "; ")))
+		}
+		h.text.WriteString("</li>\n")
+	}
+	h.text.WriteString("</ul>\n")
+}
+
+func (h *hypertext) end() string {
+	h.text.WriteString("</body>\n")
+	h.text.WriteString("</html>\n")
+	return h.text.String()
+}
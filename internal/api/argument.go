@@ -17,6 +17,14 @@ package api
 type Argument interface {
 	Name() string
 	Description() string
-	Extract(map[string]string, []string) []string
+	// Arg returns the argument as it is printed in the usage line e.g. NAME or [NAME].
+	Arg() string
+	// Optional reports whether the argument can be omitted by the end user.
+	Optional() bool
+	Extract(map[string]string, map[string]any, []string) ([]string, error)
+	// Value returns the default value of an optional argument.
+	Value() string
+	// Completer returns the completer of the argument, or nil.
+	Completer() Completer
 	String(int) string
 }
@@ -16,7 +16,11 @@ package api
 
 type Arguments interface {
 	Names() []string
-	Extract(map[string]string, []string) ([]string, error)
+	// Arg returns the arguments as they are printed in the usage line e.g. SRC [DST].
+	Arg() string
+	Extract(map[string]string, map[string]any, []string) ([]string, error)
 	Count() int
+	// List returns all arguments in the order they are declared.
+	List() []Argument
 	String() string
 }
@@ -17,8 +17,18 @@ package api
 type Command interface {
 	Name() string
 	Description() string
-	Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error)
+	Exec(path []string, sources Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error)
 	Namespace() Namespace
 	String(int) string
 	Help() string
+	// Usage returns the usage message of the command, where path is its full path.
+	Usage(path string) string
+	// Statement returns the statement text of the command, where path is its full path.
+	Statement(path string) string
+	Options() Options
+	Flags() Flags
+	Arguments() Arguments
+	Variadic() Variadic
+	// Commands returns the sub commands of a parent, or nil.
+	Commands() []Command
 }
@@ -15,8 +15,10 @@
 package api
 
 type Commands interface {
-	Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error)
+	Exec(path []string, sources Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error)
 	Namespace() Namespace
 	Names() []string
+	// List returns all commands in the order they are declared.
+	List() []Command
 	String() string
 }
@@ -0,0 +1,24 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package api
+
+// Completer completes the value of an option or an argument, see cli.Completed and
+// cli.CompletedArgument. path, sources, options, flags and values are what is parsed
+// so far, named and arguments hold the given arguments (by name) and variadic holds
+// the rest. It returns the candidates of partial (the word being completed) and the
+// directive.
+type Completer interface {
+	Complete(path []string, sources Sources, options map[string]string, flags map[string]bool, values map[string]any, named map[string]string, arguments map[string]any, variadic []string, partial string) ([]string, int)
+}
@@ -15,9 +15,20 @@
 package api
 
 type Flag interface {
-	Extract(map[string]bool, []string) []string
-	Default(map[string]bool)
+	Extract(map[string]bool, map[string]any, []string) ([]string, error)
+	Default(map[string]bool, map[string]any)
 	SName() string
 	LName() string
+	// Label returns the long name as it is printed in the Flags section e.g. --recursive.
+	Label() string
+	// Env returns the name of the environment variable bound to the flag, or empty string.
+	Env() string
+	// Description returns the description of the flag.
+	Description() string
+	// Kind describes how the flag is counted.
+	Kind() Kind
+	// Value returns the default value as it is printed in the usage message e.g. true,
+	// or empty string when the flag is lowered by default.
+	Value() string
 	String(int) string
 }
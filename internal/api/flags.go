@@ -15,9 +15,13 @@
 package api
 
 type Flags interface {
-	Extract(map[string]bool, []string) []string
-	Default(map[string]bool)
+	Extract(map[string]bool, map[string]any, []string) ([]string, error)
+	Default(map[string]bool, map[string]any)
+	// Resolve sets the value of flags which are not given on the command line from sources.
+	Resolve([]string, Sources, map[string]bool, map[string]any) error
 	Names() []string
 	Count() int
+	// List returns all flags in the order they are declared.
+	List() []Flag
 	String() string
 }
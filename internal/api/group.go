@@ -16,13 +16,17 @@ package api
 
 type Group interface {
 	Exec(path []string,
+		sources Sources,
 		options map[string]string,
 		flags map[string]bool,
+		values map[string]any,
 		args []string) (bool, error)
 	// Name returns group name
 	Name() string
 	// Names returns commands name
 	Names() []string
 	Namespace() Namespace
+	// Commands returns the commands of the group in the order they are declared.
+	Commands() []Command
 	String() string
 }
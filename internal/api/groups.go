@@ -15,7 +15,9 @@
 package api
 
 type Groups interface {
-	Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error)
+	Exec(path []string, sources Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error)
 	Namespace() Namespace
+	// List returns all groups in the order they are declared.
+	List() []Group
 	String() string
 }
@@ -0,0 +1,39 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package api
+
+// Kind describes the values accepted by an option or a flag, it is used to generate
+// completion scripts and documentation.
+type Kind struct {
+	// Type is the Go type of the converted value e.g. int or time.Duration, or empty
+	// string when the value is kept as text, see cli.IntOption.
+	Type string
+	// Repeatable reports whether the option can be given many times, in such case
+	// Min and Max bound the number of values, zero Max means no upper limit.
+	Repeatable bool
+	Min        int
+	Max        int
+	// Separator splits each given value into many values, see cli.ListOption.
+	Separator string
+	// Map reports whether the values are key=value entries, see cli.MapOption.
+	Map bool
+	// Attached reports whether a value is accepted only when it is attached to the
+	// option name (e.g. --color=always), the option can be given alone as well, in
+	// such case Implicit is its value.
+	Attached bool
+	Implicit string
+	// Count reports whether the flag counts how many times it is raised.
+	Count bool
+}
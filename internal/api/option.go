@@ -15,9 +15,26 @@
 package api
 
 type Option interface {
-	Extract(map[string]string, []string) []string
-	Default(map[string]string)
+	Extract(map[string]string, map[string]any, []string) ([]string, error)
+	Default(map[string]string, map[string]any)
+	Validate(map[string]string, map[string]any) error
 	SName() string
 	LName() string
+	// Label returns the long name as it is printed in the Options section e.g. --output=VALUE.
+	Label() string
+	// Choices returns the accepted values, or nil when any value is accepted.
+	Choices() []string
+	// Env returns the name of the environment variable bound to the option, or empty string.
+	Env() string
+	// Description returns the description of the option.
+	Description() string
+	// Value returns the default value as it is printed in the usage message.
+	Value() string
+	// Required reports whether the option must be given by the end user.
+	Required() bool
+	// Completer returns the completer of the option value, or nil.
+	Completer() Completer
+	// Kind describes the accepted values.
+	Kind() Kind
 	String(int) string
 }
@@ -15,10 +15,15 @@
 package api
 
 type Options interface {
-	Extract(map[string]string, []string) []string
-	Default(to map[string]string)
+	Extract(map[string]string, map[string]any, []string) ([]string, error)
+	Default(map[string]string, map[string]any)
+	// Resolve sets the value of options which are not given on the command line from sources.
+	Resolve([]string, Sources, map[string]string, map[string]any) error
+	Validate(map[string]string, map[string]any) error
 	Names() []string
 	Has(string) bool
 	Count() int
+	// List returns all options in the order they are declared.
+	List() []Option
 	String() string
 }
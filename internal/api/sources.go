@@ -0,0 +1,35 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package api
+
+// Sources supplies values of options and flags which are not given on the command
+// line, and holds the state of a single run of the application.
+type Sources interface {
+	// Env returns the value of the environment variable name.
+	Env(name string) (string, bool)
+	// Config returns the value(s) of the option or flag named name of the command at path
+	// from the configuration file.
+	Config(path []string, name string) ([]string, bool)
+	// Record records where the value of the option or flag named name came from.
+	Record(name string, origin int)
+	// Origin returns where the value of the option or flag named name came from.
+	Origin(name string) int
+	// Defer registers a check of a nested application or a parent (e.g. required
+	// options), which is run by the selected command after it handles --help.
+	Defer(check func() error)
+	// Checks runs the deferred checks in the order they are registered, and returns
+	// the first error.
+	Checks() error
+}
@@ -18,5 +18,12 @@ type Variadic interface {
 	Arg() string
 	Allowed() bool
 	Extract([]string) ([]string, error)
+	// Name returns the name of the variadic argument e.g. FILE.
+	Name() string
+	Description() string
+	// Min returns the least number of values which must be given.
+	Min() int
+	// Max returns the most number of values which can be given, zero means no upper limit.
+	Max() int
 	String() string
 }
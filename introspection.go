@@ -0,0 +1,75 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+// Introspection returns app with a hidden command (app __spec), which prints the
+// model of the whole application tree as JSON to the standard output, see
+// cli.Describe and cli.DescribeJSON. The command is not listed in usage messages,
+// completion scripts or generated documentation, and it is recognized only as the
+// first argument of the application.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+//   - app already has a command named __spec.
+func Introspection(app Application) Application {
+	runner := runnable("cli.Introspection", app)
+	for _, child := range runner.tree().children() {
+		if child.name() == "__spec" {
+			panic("cli.Introspection: app already has a command named __spec")
+		}
+	}
+	return introspection{
+		app: runner,
+	}
+}
+
+type introspection struct {
+	app runner
+}
+
+func (i introspection) Run(args []string) error {
+	return i.start(args, sources())
+}
+
+func (i introspection) builtin(name, description string) runner {
+	i.app = i.app.builtin(name, description)
+	return i
+}
+
+func (i introspection) tree() node {
+	return i.app.tree()
+}
+
+func (i introspection) start(args []string, sources _sources) error {
+	if len(args) < 2 || args[1] != "__spec" {
+		return i.app.start(args, sources)
+	}
+	root := i.app.tree()
+	implementation := Function(func(ctx Context) error {
+		data, err := specJSON(root)
+		if err != nil {
+			return err
+		}
+		_, err = sources.stdout.Write(data)
+		return err
+	})
+	cmd := Command("__spec", "Print the model of the application as JSON.", Statements(),
+		Options(), Flags(), Arguments(), NoVariadic(), implementation)
+	options := make(map[string]string)
+	flags := make(map[string]bool)
+	values := make(map[string]any)
+	_, err := cmd.Exec(root.path, sources, options, flags, values, args[1:])
+	return locate(err, len(args))
+}
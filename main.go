@@ -0,0 +1,93 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Exit statuses used by cli.Execute.
+const (
+	ExitSuccess = 0
+	ExitFailure = 1
+	ExitUsage   = 2
+)
+
+// Main runs app with os.Args, prints the outcome to the standard output or
+// the standard error and exits the process, see cli.Execute.
+//
+//	func main() {
+//		cli.Main(app)
+//	}
+func Main(app Application) {
+	os.Exit(Execute(app, os.Args, os.Stdout, os.Stderr))
+}
+
+// Execute runs app with args and returns the exit status of the application:
+//   - cli.HelpRequested: usage message is printed to stdout and 0 is returned.
+//   - *cli.UsageError: usage message is printed to stderr and 2 is returned.
+//   - cli.ExitCoder: error is printed to stderr and its ExitCode() is returned.
+//   - any other error: error is printed to stderr and 1 is returned.
+//
+// Execute is meant to be used by tests, where stdout and stderr are buffers.
+//
+// # Panic when:
+//   - app is nil.
+//   - stdout or stderr is nil.
+func Execute(app Application, args []string, stdout, stderr io.Writer) int {
+	if app == nil {
+		panic("cli.Execute: app cannot be nil")
+	}
+	if stdout == nil || stderr == nil {
+		panic("cli.Execute: stdout and stderr cannot be nil")
+	}
+	err := run(app, args, stdout)
+	if err == nil {
+		return ExitSuccess
+	}
+	var help HelpRequested
+	if errors.As(err, &help) {
+		fmt.Fprint(stdout, help.Usage)
+		return ExitSuccess
+	}
+	var usage *UsageError
+	if errors.As(err, &usage) {
+		fmt.Fprint(stderr, usage.Usage)
+		return ExitUsage
+	}
+	if msg := err.Error(); msg != "" {
+		fmt.Fprintln(stderr, summary(err))
+	}
+	var coder ExitCoder
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+	return ExitFailure
+}
+
+// run runs app with args, where built-in commands (e.g. completion) print their
+// output to stdout.
+func run(app Application, args []string, stdout io.Writer) error {
+	runner, ok := app.(runner)
+	if !ok {
+		return app.Run(args)
+	}
+	sources := sources()
+	sources.stdout = stdout
+	return runner.start(args, sources)
+}
@@ -0,0 +1,67 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type exitError int
+
+func (e exitError) Error() string {
+	return "conflict"
+}
+
+func (e exitError) ExitCode() int {
+	return int(e)
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		result error
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{nil, []string{"tool", "run"}, ExitSuccess, "", ""},
+		{nil, []string{"tool", "--help"}, ExitSuccess, "Usage: tool", ""},
+		{nil, []string{"tool", "run", "--help"}, ExitSuccess, "Usage: tool run", ""},
+		{nil, []string{"tool", "walk"}, ExitUsage, "", "Error: "},
+		{errors.New("broken"), []string{"tool", "run"}, ExitFailure, "", "Error: broken\n"},
+		{exitError(3), []string{"tool", "run"}, 3, "", "Error: conflict\n"},
+		{exitError(4), []string{"tool", "run"}, 4, "", "Error: conflict\n"},
+	}
+	for _, test := range tests {
+		result := test.result
+		implementation := Function(func(Context) error { return result })
+		cmd := Command("run", "Run.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), implementation)
+		app := Nested("tool", "A tool.", Statements(), Options(), Flags(), Group("Main", cmd))
+		var stdout, stderr bytes.Buffer
+		code := Execute(app, test.args, &stdout, &stderr)
+		if code != test.code {
+			t.Errorf("%q: got exit %d, want %d", test.args, code, test.code)
+		}
+		if !strings.Contains(stdout.String(), test.stdout) || test.stdout == "" && stdout.Len() > 0 {
+			t.Errorf("%q: unexpected stdout %q", test.args, stdout.String())
+		}
+		if !strings.Contains(stderr.String(), test.stderr) || test.stderr == "" && stderr.Len() > 0 {
+			t.Errorf("%q: unexpected stderr %q", test.args, stderr.String())
+		}
+	}
+}
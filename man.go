@@ -0,0 +1,132 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ManPages returns a man page in roff format for every command path of app, the
+// application itself included. Pages are keyed by their file name, which is the
+// command path joined by hyphen followed by the manual section e.g.
+// git-remote-add.1, so they can be written as is into a man directory:
+//
+//	for file, page := range cli.ManPages(app, 1) {
+//		os.WriteFile(filepath.Join("man1", file), []byte(page), 0o644)
+//	}
+//
+// Every page consists of NAME, SYNOPSIS, DESCRIPTION, the sub commands grouped by
+// their sections, OPTIONS, FLAGS and ARGUMENTS, followed by the statement text
+// of the command, where a paragraph starting with a line such as "Examples:"
+// becomes a section of that title, and SEE ALSO linking to the parent and sub
+// command pages. Pages do not carry a date so they are reproducible.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+//   - section is not between 1 and 9.
+func ManPages(app Application, section int) map[string]string {
+	root := runnable("cli.ManPages", app).tree()
+	if section < 1 || section > 9 {
+		panic("cli.ManPages: section must be between 1 and 9")
+	}
+	pages := make(map[string]string)
+	manPages(pages, root, nil, root.name(), strconv.Itoa(section))
+	return pages
+}
+
+func manPages(pages map[string]string, n node, parent []string, source, section string) {
+	pages[manName(n.path)+"."+section] = manPage(n, parent, source, section)
+	for _, child := range n.children() {
+		manPages(pages, child, n.path, source, section)
+	}
+}
+
+// manPage returns the roff page of n, where parent is the path of its parent.
+func manPage(n node, parent []string, source, section string) string {
+	var text strings.Builder
+	title := strings.ToUpper(manName(n.path))
+	fmt.Fprintf(&text, ".TH \"%s\" \"%s\" \"\" \"%s\" \"\"\n", roffQuoted(title), section, roffQuoted(source))
+	text.WriteString(".SH NAME\n")
+	fmt.Fprintf(&text, "%s \\- %s\n", roff(manName(n.path)), roff(n.description))
+	text.WriteString(".SH SYNOPSIS\n")
+	usage := strings.TrimPrefix(n.synopsis(), n.fullPath())
+	fmt.Fprintf(&text, "\\fB%s\\fR%s\n", roff(n.fullPath()), roff(usage))
+	text.WriteString(".SH DESCRIPTION\n")
+	fmt.Fprintf(&text, "%s\n", roff(n.description))
+	for _, list := range n.lists() {
+		fmt.Fprintf(&text, ".SH %s\n", roff(strings.ToUpper(list.title)))
+		for _, item := range list.items {
+			manItem(&text, item.term, item.description, item.notes)
+		}
+	}
+	for _, topic := range n.topics() {
+		fmt.Fprintf(&text, ".SH %s\n", roff(strings.ToUpper(topic.title)))
+		text.WriteString(".nf\n")
+		for _, line := range topic.lines {
+			fmt.Fprintf(&text, "%s\n", roff(line))
+		}
+		text.WriteString(".fi\n")
+	}
+	related := n.related(parent)
+	if len(related) > 0 {
+		text.WriteString(".SH SEE ALSO\n")
+		for i, item := range related {
+			separator := ","
+			if i == len(related)-1 {
+				separator = ""
+			}
+			fmt.Fprintf(&text, ".BR %s (%s)%s\n", roff(manName(item.path)), section, separator)
+		}
+	}
+	return text.String()
+}
+
+// manItem writes a tagged paragraph of the given term, description and notes.
+func manItem(text *strings.Builder, term, description string, notes []string) {
+	text.WriteString(".TP\n")
+	fmt.Fprintf(text, "\\fB%s\\fR\n", roff(term))
+	text.WriteString(roff(description))
+	text.WriteString("\n")
+	for _, note := range notes {
+		text.WriteString(".br\n")
+		fmt.Fprintf(text, "%s\n", roff(note))
+	}
+}
+
+// manName returns the name of the page of path e.g. git-remote-add.
+func manName(path []string) string {
+	return strings.Join(path, "-")
+}
+
+// roff escapes text so it is printed as is by troff.
+func roff(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\e`)
+	text = strings.ReplaceAll(text, "-", `\-`)
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[i] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// roffQuoted escapes text so it can be a quoted argument of a roff macro, where
+// a double quote cannot be escaped by a backslash.
+func roffQuoted(text string) string {
+	return strings.ReplaceAll(roff(text), `"`, `\(dq`)
+}
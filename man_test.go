@@ -0,0 +1,54 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// joined returns files as one text, ordered by their names.
+func joined(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var text strings.Builder
+	for _, name := range names {
+		text.WriteString("==> " + name + " <==\n")
+		text.WriteString(files[name])
+	}
+	return text.String()
+}
+
+func TestManPages(t *testing.T) {
+	golden(t, "man.txt", joined(ManPages(fixture(), 1)))
+}
+
+func TestRoffQuoted(t *testing.T) {
+	tests := map[string]string{
+		`tool`:        `tool`,
+		`say "hi"`:    `say \(dqhi\(dq`,
+		`a\b-c`:       `a\eb\-c`,
+		`.hidden "x"`: `\&.hidden \(dqx\(dq`,
+	}
+	for text, want := range tests {
+		if got := roffQuoted(text); got != want {
+			t.Errorf("roffQuoted(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// DuplicateKeys decides what MapOption does when the end user gives the same key twice.
+type DuplicateKeys int
+
+const (
+	// RejectDuplicateKeys reports the second occurrence of a key as a usage error.
+	RejectDuplicateKeys DuplicateKeys = iota
+	// LastKeyWins keeps the value of the last occurrence of a key.
+	LastKeyWins
+)
+
+// MapOption represents a repeatable Option which accepts key=value entries
+// (e.g. -D env=prod -D region=eu or --label team=core), see cli.Option.
+// Only the first = separates the key from the value, so the value may contain =.
+//
+// The entries can be accessed by cli.Context.OptionMap("key") as map[string]string,
+// while cli.Context.Option("key") returns the last given entry as is.
+//
+// Entry without = or with an empty key is reported as a usage error, so is a
+// duplicated key when duplicates is cli.RejectDuplicateKeys.
+//
+// # Panic when:
+//   - see cli.Option.
+func MapOption(sname, lname, description string, duplicates DuplicateKeys) mapOption {
+	return mapOption{
+		option:     Option(sname, lname, description, ""),
+		duplicates: duplicates,
+	}
+}
+
+type mapOption struct {
+	option
+	duplicates DuplicateKeys
+}
+
+func (o mapOption) Kind() api.Kind {
+	return api.Kind{Repeatable: true, Map: true}
+}
+
+func (o mapOption) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	name, text, rest, ok := o.match(args)
+	if !ok {
+		return args, nil
+	}
+	key, value, ok := strings.Cut(text, "=")
+	if !ok || key == "" {
+		return args, valueError(InvalidValue, args, rest, name, text, errors.New("key=value is expected"))
+	}
+	entries, ok := values[o.key()].(map[string]string)
+	if !ok {
+		entries = make(map[string]string)
+		o.store(values, entries)
+	}
+	if _, ok := entries[key]; ok && o.duplicates == RejectDuplicateKeys {
+		return args, valueError(DuplicateKey, args, rest, name, text, fmt.Errorf("key (%s) is already given", key))
+	}
+	entries[key] = value
+	o.set(opts, text)
+	return rest, nil
+}
+
+func (o mapOption) Default(opts map[string]string, values map[string]any) {
+	o.option.Default(opts, values)
+	if _, ok := values[o.key()]; !ok {
+		o.store(values, make(map[string]string))
+	}
+}
+
+func (o mapOption) String(width int) string {
+	return o.render(width, o.Label(), "(key=value, repeatable)")
+}
@@ -0,0 +1,104 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// markdown is the writer of cli.MarkdownDocs.
+type markdown struct {
+	extension string
+	text      strings.Builder
+}
+
+func (m *markdown) begin(title, frontMatter string) {
+	if frontMatter != "" {
+		m.text.WriteString(frontMatter)
+		if !strings.HasSuffix(frontMatter, "\n") {
+			m.text.WriteString("\n")
+		}
+		m.text.WriteString("\n")
+	}
+	fmt.Fprintf(&m.text, "# %s\n", escapeMarkdown(title))
+}
+
+func (m *markdown) heading(text string) {
+	fmt.Fprintf(&m.text, "\n## %s\n", escapeMarkdown(text))
+}
+
+func (m *markdown) paragraph(text string) {
+	fmt.Fprintf(&m.text, "\n%s\n", escapeMarkdown(text))
+}
+
+func (m *markdown) code(lines []string) {
+	fence := "```"
+	for strings.Contains(strings.Join(lines, "\n"), fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(&m.text, "\n%s\n", fence)
+	for _, line := range lines {
+		fmt.Fprintf(&m.text, "%s\n", line)
+	}
+	fmt.Fprintf(&m.text, "%s\n", fence)
+}
+
+func (m *markdown) list(items []item) {
+	m.text.WriteString("\n")
+	for _, item := range items {
+		term := codeSpan(item.term)
+		if item.path != nil {
+			term = fmt.Sprintf("[%s](%s)", term, docFile(item.path, m.extension))
+		}
+		fmt.Fprintf(&m.text, "- %s", term)
+		if item.description != "" {
+			fmt.Fprintf(&m.text, ": %s", escapeMarkdown(item.description))
+		}
+		if len(item.notes) > 0 {
+			fmt.Fprintf(&m.text, " (%s)", escapeMarkdown(strings.Join(item.notes, "; ")))
+		}
+		m.text.WriteString("\n")
+	}
+}
+
+func (m *markdown) end() string {
+	return m.text.String()
+}
+
+// escapeMarkdown escapes text so it is rendered as is by Markdown.
+func escapeMarkdown(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		if strings.ContainsRune("\\`*_[]<>#|", r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+// codeSpan returns text as a Markdown code span, which is delimited by a backtick
+// run longer than any run in text.
+func codeSpan(text string) string {
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+	return fence + text + fence
+}
@@ -0,0 +1,105 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// NegatableFlag represents a Flag which can be explicitly turned on or off by
+// the end user, which matters when the default value is true, or when it may
+// come from elsewhere, see cli.Flag.
+//
+// The flag is raised by --color (or the short name -c) and lowered by --no-color,
+// the value can also be given explicitly e.g. --color=false, where the accepted
+// values are true, false, 1, 0, t and f. Invalid value is reported as a usage error.
+// value is the default, which is used when the end user neither raises nor lowers
+// the flag.
+//
+// # Panic when:
+//   - lname is empty (--no-lname cannot be formed).
+//   - see cli.Flag.
+func NegatableFlag(sname, lname, description string, value bool) negatableFlag {
+	if strings.TrimSpace(lname) == "" {
+		panic("cli.NegatableFlag: lname cannot be empty")
+	}
+	return negatableFlag{
+		flag:  Flag(sname, lname, description),
+		value: value,
+	}
+}
+
+type negatableFlag struct {
+	flag
+	value bool
+}
+
+func (f negatableFlag) Extract(flags map[string]bool, values map[string]any, args []string) ([]string, error) {
+	if len(args) < 1 {
+		return args, nil
+	}
+	if key, ok := strings.CutPrefix(args[0], "--"); ok {
+		if key == "no-"+f.lname {
+			f.set(flags, false)
+			return args[1:], nil
+		}
+		if text, ok := strings.CutPrefix(key, f.lname+"="); ok {
+			value, err := strconv.ParseBool(text)
+			if err != nil {
+				err := parseError(InvalidValue, args)
+				err.Token = text
+				err.Option = "--" + f.lname
+				err.Err = errors.New("boolean is expected")
+				return args, err
+			}
+			f.set(flags, value)
+			return args[1:], nil
+		}
+	}
+	return f.flag.Extract(flags, values, args)
+}
+
+func (f negatableFlag) Default(flags map[string]bool, values map[string]any) {
+	if _, ok := flags[f.lname]; !ok {
+		f.set(flags, f.value)
+	}
+}
+
+func (f negatableFlag) set(flags map[string]bool, value bool) {
+	flags[f.lname] = value
+	if f.sname != "" {
+		flags[f.sname] = value
+	}
+}
+
+func (f negatableFlag) Value() string {
+	if f.value {
+		return "true"
+	}
+	return ""
+}
+
+func (f negatableFlag) Label() string {
+	return "--[no-]" + f.lname
+}
+
+func (f negatableFlag) String(width int) string {
+	if value := f.Value(); value != "" {
+		return f.render(width, f.Label(), "("+value+")")
+	}
+	return f.render(width, f.Label(), "")
+}
@@ -15,6 +15,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -47,6 +48,7 @@ func Nested(name, description string, statement Statement, options api.Options,
 			panic("cli.Nested: nil value is not allowed in varGroups")
 		}
 	}
+	verify("cli.Nested", statement, options, flags)
 	groups := groups(varGroups)
 	namespace := groups.Namespace()
 	if err := namespace.Add(name); err != nil {
@@ -85,68 +87,126 @@ type nested struct {
 }
 
 func (a nested) Run(args []string) error {
+	return a.start(args, sources())
+}
+
+func (a nested) start(args []string, sources _sources) error {
+	return locate(a.run(args, sources), len(args))
+}
+
+func (a nested) run(args []string, sources _sources) error {
+	path := []string{a.name}
 	if len(args) == 0 {
-		return fmt.Errorf(a.usage())
+		err := errors.New("application name is missing")
+		return usageError(path, Unspecified, "", err, a.usage(summary(err)))
 	}
 	// name := args[0]
 	name := removeAbsolutePath(args[0])
 	if a.name != name {
-		return fmt.Errorf(a.usage())
-
+		err := fmt.Errorf("unexpected application name (%s)", name)
+		return usageError(path, Unspecified, args[0], err, a.usage(summary(err)))
 	}
-	path := []string{a.name}
 	args = args[1:]
 	options := make(map[string]string, 0)
 	flags := make(map[string]bool, 0)
-	args = a.extract(options, flags, args)
-	a.options.Default(options)
-	a.flags.Default(flags)
+	values := make(map[string]any, 0)
+	args, err := a.extract(options, flags, values, args)
+	if err != nil {
+		return failure(path, err, a.usage)
+	}
+	if err := a.options.Resolve(path, sources, options, values); err != nil {
+		return failure(path, err, a.usage)
+	}
+	if err := a.flags.Resolve(path, sources, flags, values); err != nil {
+		return failure(path, err, a.usage)
+	}
+	// constraints count every value which is not a default value
+	set := given(options, flags)
+	a.options.Default(options, values)
+	a.flags.Default(flags, values)
 	if len(args) == 0 {
-		return fmt.Errorf(a.usage("Error: no command was selected"))
+		return parseFailure(path, parseError(NoCommandSelected, args), a.usage)
 	}
 	if args[0] == "--help" {
-		return fmt.Errorf(a.usage())
+		return helpRequested(path, a.usage())
 	}
 	if strings.HasPrefix(args[0], "-") {
 		if a.options.Has(args[0]) { //done
-			msg := fmt.Sprintf("Error: missing value for %s option (e.g. %[1]s value)", args[0])
-			return fmt.Errorf(a.usage(msg))
+			return parseFailure(path, parseError(MissingOptionValue, args), a.usage)
 		}
 		if a.options.Count() > 0 && a.flags.Count() > 0 { //done
-			msg := fmt.Sprintf("Error: unknown option or flag (%s)", args[0])
-			return fmt.Errorf(a.usage(msg))
+			return parseFailure(path, parseError(UnknownOptionOrFlag, args), a.usage)
 		}
 		if a.options.Count() > 0 { //done
-			msg := fmt.Sprintf("Error: unknown option (%s)", args[0])
-			return fmt.Errorf(a.usage(msg))
+			return parseFailure(path, parseError(UnknownOption, args), a.usage)
 		}
 		if a.flags.Count() > 0 { //done
-			msg := fmt.Sprintf("Error: unknown flag (%s)", args[0])
-			return fmt.Errorf(a.usage(msg))
+			return parseFailure(path, parseError(UnknownFlag, args), a.usage)
 		}
 		//done
-		msg := fmt.Sprintf("Error: unknown command (%s)", args[0])
-		return fmt.Errorf(a.usage(msg))
+		return parseFailure(path, parseError(UnknownCommand, args), a.usage)
 	}
-	ok, err := a.groups.Exec(path, options, flags, args)
+	// checked by the selected command, so its --help is not blocked by missing options
+	sources.Defer(func() error {
+		if err := a.options.Validate(options, values); err != nil {
+			return failure(path, err, a.usage)
+		}
+		if err := check(a.statement, set); err != nil {
+			return failure(path, err, a.usage)
+		}
+		return nil
+	})
+	ok, err := a.groups.Exec(path, sources, options, flags, values, args)
 	if err != nil {
 		return err
 	}
 	if ok {
 		return nil
 	}
-	msg := fmt.Sprintf("Error: unknown command (%s)", args[0])
-	return fmt.Errorf(a.usage(msg))
+	return parseFailure(path, parseError(UnknownCommand, args), a.usage)
+}
+
+func (a nested) builtin(name, description string) runner {
+	a.statement = listed(a.statement, name, description)
+	return a
+}
+
+func (a nested) tree() node {
+	root := node{
+		path:        []string{a.name},
+		description: a.description,
+		usage:       a.usage(),
+		statement:   a.statement.String(a.name),
+		options:     a.options.List(),
+		flags:       a.flags.List(),
+		variadic:    NoVariadic(),
+	}
+	for _, group := range a.groups.List() {
+		commands := section{name: group.Name()}
+		for _, cmd := range group.Commands() {
+			commands.nodes = append(commands.nodes, describe(root.path, cmd))
+		}
+		root.sections = append(root.sections, commands)
+	}
+	return root
 }
 
-func (a nested) extract(options map[string]string, flags map[string]bool, args []string) []string {
-	length := len(args)
-	args = a.options.Extract(options, args)
-	args = a.flags.Extract(flags, args)
-	if length != len(args) {
-		args = a.extract(options, flags, args)
-	}
-	return args
+func (a nested) extract(options map[string]string, flags map[string]bool, values map[string]any, args []string) ([]string, error) {
+	length, first := len(args), ""
+	if length > 0 {
+		first = args[0]
+	}
+	args, err := a.options.Extract(options, values, args)
+	if err != nil {
+		return args, err
+	}
+	if args, err = a.flags.Extract(flags, values, args); err != nil {
+		return args, err
+	}
+	if length != len(args) || (length > 0 && first != args[0]) { // grouped flags are consumed one by one
+		return a.extract(options, flags, values, args)
+	}
+	return args, nil
 }
 
 func (a nested) usage(errors ...string) string {
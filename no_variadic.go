@@ -14,11 +14,6 @@
 
 package cli
 
-import (
-	"fmt"
-	"strings"
-)
-
 // NoVariadic prevents additional value to be passed after named arguments
 // (cli.Argument). invoking cli.Context.Variadic() method will returns
 // empty slice of string ([]string)
@@ -39,6 +34,22 @@ func (v noVariadic) Arg() string {
 	return ""
 }
 
+func (v noVariadic) Name() string {
+	return ""
+}
+
+func (v noVariadic) Description() string {
+	return ""
+}
+
+func (v noVariadic) Min() int {
+	return 0
+}
+
+func (v noVariadic) Max() int {
+	return 0
+}
+
 func (v noVariadic) Allowed() bool {
 	return false
 }
@@ -47,13 +58,9 @@ func (v noVariadic) Extract(args []string) ([]string, error) {
 	if len(args) == 0 {
 		return args, nil
 	}
-	if len(args) == 1 {
-		msg := fmt.Sprintf("Error: unexpected value (%s)", args[0])
-		return args, fmt.Errorf(msg)
-
-	}
-	msg := fmt.Sprintf("Error: unexpected values (%s)", strings.Join(args, ", "))
-	return args, fmt.Errorf(msg)
+	err := parseError(UnexpectedValue, args)
+	err.Values = args
+	return args, err
 }
 
 func (v noVariadic) String() string {
@@ -17,6 +17,8 @@ package cli
 import (
 	"fmt"
 	"strings"
+
+	"github.com/begopher/cli/internal/api"
 )
 
 // Option represents a command line option which may has a short and/or a long name.
@@ -30,6 +32,10 @@ import (
 // given by the client of your application can be accessed by cli.Context.Option("key").
 // Where key can be ethier Option's short name and/or long name.
 //
+// End user may give the value as a separate item (--output json, -o json), or attach
+// it to the name (--output=json, -ojson, -o=json). The value may contain = itself,
+// only the first = after the long name is used as a separator.
+//
 // Value can also be accessed by cli.Context.Options() which returns map[string]string
 // of all options with associated values.
 //
@@ -83,31 +89,69 @@ type option struct {
 	value       string
 }
 
-func (o option) Extract(opts map[string]string, args []string) []string {
-	if len(args) < 2 {
-		return args
+func (o option) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	_, value, rest, ok := o.match(args)
+	if !ok {
+		return args, nil
+	}
+	o.set(opts, value)
+	return rest, nil
+}
+
+// match reports whether args start with the option, and returns the name
+// used by the end user (e.g. --output), the given value and the remaining args.
+func (o option) match(args []string) (name, value string, rest []string, ok bool) {
+	if len(args) < 1 {
+		return "", "", args, false
 	}
 	if args[0] == "-" || args[0] == "--" || strings.HasPrefix(args[0], "---") {
-		return args
+		return "", "", args, false
 	}
-	if key, ok := strings.CutPrefix(args[0], "--"); ok && key == o.lname {
-		opts[o.lname] = args[1]
-		if o.sname != "" {
-			opts[o.sname] = args[1]
+	if key, ok := strings.CutPrefix(args[0], "--"); ok {
+		if o.lname == "" {
+			return "", "", args, false
+		}
+		name = "--" + o.lname
+		if value, ok := strings.CutPrefix(key, o.lname+"="); ok { // --lname=value
+			return name, value, args[1:], true
 		}
-		return args[2:]
+		if key == o.lname && len(args) > 1 { // --lname value
+			return name, args[1], args[2:], true
+		}
+		return "", "", args, false
 	}
-	if key, ok := strings.CutPrefix(args[0], "-"); ok && key == o.sname {
-		opts[o.sname] = args[1]
-		if o.lname != "" {
-			opts[o.lname] = args[1]
+	if key, ok := strings.CutPrefix(args[0], "-"); ok && o.sname != "" {
+		name = "-" + o.sname
+		if key == o.sname && len(args) > 1 { // -s value
+			return name, args[1], args[2:], true
+		}
+		if value, ok := strings.CutPrefix(key, o.sname); ok && value != "" { // -svalue or -s=value
+			return name, strings.TrimPrefix(value, "="), args[1:], true
 		}
-		return args[2:]
 	}
-	return args
+	return "", "", args, false
 }
 
-func (o option) Default(opts map[string]string) {
+func (o option) set(opts map[string]string, value string) {
+	if o.lname != "" {
+		opts[o.lname] = value
+	}
+	if o.sname != "" {
+		opts[o.sname] = value
+	}
+}
+
+// store saves the converted value of the option in values under both names.
+func (o option) store(values map[string]any, value any) {
+	if o.lname != "" {
+		values[o.lname] = value
+	}
+	if o.sname != "" {
+		values[o.sname] = value
+	}
+}
+
+func (o option) Default(opts map[string]string, values map[string]any) {
 	_, ok := opts[o.lname]
 	if !ok && o.lname != "" {
 		opts[o.lname] = o.value
@@ -124,6 +168,37 @@ func (o option) Default(opts map[string]string) {
 	//}
 }
 
+func (o option) Validate(opts map[string]string, values map[string]any) error {
+	return nil
+}
+
+// key returns the name which is used to look up the option's value.
+func (o option) key() string {
+	return optionKey(o.sname, o.lname)
+}
+
+// display returns the name which is used to refer to the option in messages.
+func (o option) display() string {
+	return optionDisplay(o.sname, o.lname)
+}
+
+// optionKey returns the name which is used to look up the value of any option,
+// including the ones which wrap api.Option e.g. cli.Required.
+func optionKey(sname, lname string) string {
+	if lname != "" {
+		return lname
+	}
+	return sname
+}
+
+// optionDisplay returns the name which is used to refer to any option in messages.
+func optionDisplay(sname, lname string) string {
+	if lname != "" {
+		return "--" + lname
+	}
+	return "-" + sname
+}
+
 func (o option) SName() string {
 	return o.sname
 }
@@ -132,22 +207,59 @@ func (o option) LName() string {
 	return o.lname
 }
 
+func (o option) Description() string {
+	return o.description
+}
+
+func (o option) Value() string {
+	return o.value
+}
+
+func (o option) Required() bool {
+	return false
+}
+
+func (o option) Completer() api.Completer {
+	return nil
+}
+
+func (o option) Kind() api.Kind {
+	return api.Kind{}
+}
+
+func (o option) Choices() []string {
+	return nil
+}
+
+func (o option) Env() string {
+	return ""
+}
+
+func (o option) Label() string {
+	if o.lname == "" {
+		return ""
+	}
+	return "--" + o.lname + "=VALUE"
+}
+
 func (o option) String(width int) string {
+	var def string = ``
+	if o.value != "" {
+		def = fmt.Sprintf(`(%s)`, o.value)
+	}
+	return o.render(width, o.Label(), def)
+}
+
+// render returns the line of the option in Options section, where label is printed
+// in the long name column, and note is printed right after the description.
+func (o option) render(width int, label, note string) string {
 	prefix := "  "
 	sflag := "    "
 	if o.sname != "" {
 		sflag = "-" + o.sname + ", "
 	}
 
-	lflag := "  "
-	if o.lname != "" {
-		lflag = "--"
-	}
-	lflag = fmt.Sprintf("%s%-[2]*s", lflag, width, o.lname)
-	var def string = ``
-	if o.value != "" {
-		def = fmt.Sprintf(`(%s)`, o.value)
-	}
+	lflag := fmt.Sprintf("%-[1]*s", width, label)
 	msg := "%s%s%s  %s %s\n"
-	return fmt.Sprintf(msg, prefix, sflag, lflag, o.description, def)
+	return fmt.Sprintf(msg, prefix, sflag, lflag, o.description, note)
 }
@@ -0,0 +1,294 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionValueSyntaxes(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(Option("o", "output", "output format", "text"), Option("", "define", "a definition", "")),
+			Flags(Flag("v", "verbose", "verbose output")), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		output   string
+		define   string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, "text", "", Unspecified, "", 0},
+		{[]string{"tool", "--output", "json"}, "json", "", Unspecified, "", 0},
+		{[]string{"tool", "--output=json"}, "json", "", Unspecified, "", 0},
+		{[]string{"tool", "--output="}, "", "", Unspecified, "", 0},
+		{[]string{"tool", "-o", "json"}, "json", "", Unspecified, "", 0},
+		{[]string{"tool", "-ojson"}, "json", "", Unspecified, "", 0},
+		{[]string{"tool", "-o=json"}, "json", "", Unspecified, "", 0},
+		{[]string{"tool", "--define=key=value"}, "text", "key=value", Unspecified, "", 0},
+		{[]string{"tool", "--define", "key=value"}, "text", "key=value", Unspecified, "", 0},
+		{[]string{"tool", "-v", "--output=a=b", "-v"}, "a=b", "", Unspecified, "", 0},
+		{[]string{"tool", "-vovalue"}, "value", "", Unspecified, "", 0},
+		{[]string{"tool", "-vo", "value"}, "value", "", Unspecified, "", 0},
+		{[]string{"tool", "-ovv"}, "vv", "", Unspecified, "", 0},
+		{[]string{"tool", "-vx"}, "", "", UnknownOptionOrFlag, "-x", 1},
+		{[]string{"tool", "--output"}, "", "", MissingOptionValue, "--output", 1},
+		{[]string{"tool", "-v", "-o"}, "", "", MissingOptionValue, "-o", 2},
+		{[]string{"tool", "--outputs=json"}, "", "", UnknownOptionOrFlag, "--outputs=json", 1},
+		{[]string{"tool", "-v", "--out", "json"}, "", "", UnknownOptionOrFlag, "--out", 2},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if output, define := ctx.Option("output"), ctx.Option("define"); output != test.output || define != test.define {
+			t.Errorf("%v: output is %q and define is %q, want %q and %q", test.args, output, define, test.output, test.define)
+		}
+		if ctx.Option("o") != ctx.Option("output") {
+			t.Errorf("%v: short name has %q, want %q", test.args, ctx.Option("o"), ctx.Option("output"))
+		}
+	}
+	args := []string{"tool", "-vovalue"}
+	if ctx, _ := invoke(t, app, nil, args...); !ctx.Flag("verbose") || args[1] != "-vovalue" {
+		t.Errorf("grouped flags: verbose is %v and argv is %q", ctx.Flag("verbose"), args)
+	}
+	var stdout, stderr bytes.Buffer
+	Execute(app(Function(func(Context) error { return nil })), []string{"tool", "--help"}, &stdout, &stderr)
+	if !strings.Contains(stdout.String(), "-o, --output=VALUE") {
+		t.Errorf("help does not render --output=VALUE:\n%s", stdout.String())
+	}
+}
+
+func TestTypedOptions(t *testing.T) {
+	day := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(
+				IntOption("n", "number", "a number", 5),
+				UintOption("u", "unsigned", "a non-negative number", 7),
+				DurationOption("d", "delay", "a delay", time.Second),
+				TimeOption("t", "since", "a date", time.DateOnly, day),
+			),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		number   int
+		unsigned uint
+		delay    time.Duration
+		since    time.Time
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, 5, 7, time.Second, day, Unspecified, "", 0},
+		{[]string{"tool", "-n", "-3", "--unsigned=0"}, -3, 0, time.Second, day, Unspecified, "", 0},
+		{[]string{"tool", "-d1h30m", "--since", "2023-12-31"}, 5, 7, 90 * time.Minute, time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), Unspecified, "", 0},
+		{[]string{"tool", "--number", "five"}, 0, 0, 0, time.Time{}, InvalidValue, "five", 2},
+		{[]string{"tool", "-n", "1", "--unsigned=-1"}, 0, 0, 0, time.Time{}, InvalidValue, "-1", 3},
+		{[]string{"tool", "--delay=soon"}, 0, 0, 0, time.Time{}, InvalidValue, "soon", 1},
+		{[]string{"tool", "-t", "01/03/2024"}, 0, 0, 0, time.Time{}, InvalidValue, "01/03/2024", 2},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if number := Get[int](ctx, "number"); number != test.number {
+			t.Errorf("%v: number is %d, want %d", test.args, number, test.number)
+		}
+		if unsigned := Get[uint](ctx, "u"); unsigned != test.unsigned {
+			t.Errorf("%v: unsigned is %d, want %d", test.args, unsigned, test.unsigned)
+		}
+		if delay := Get[time.Duration](ctx, "delay"); delay != test.delay {
+			t.Errorf("%v: delay is %v, want %v", test.args, delay, test.delay)
+		}
+		if since := Get[time.Time](ctx, "since"); !since.Equal(test.since) {
+			t.Errorf("%v: since is %v, want %v", test.args, since, test.since)
+		}
+	}
+	_, parse := invoke(t, app, nil, "tool", "--number", "five")
+	if parse == nil || parse.Option != "--number" || !strings.Contains(parse.Error(), "integer is expected") {
+		t.Errorf("unexpected error of invalid integer: %v", parse)
+	}
+}
+
+func TestGetPanicsOnTypeMismatch(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(IntOption("n", "number", "a number", 5)),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	ctx, _ := invoke(t, app, nil, "tool")
+	if value := Get[string](ctx, "unknown"); value != "" {
+		t.Errorf("unknown key has %q, want zero value", value)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	Get[string](ctx, "number")
+}
+
+func TestRepeatableAndListOptions(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(RepeatableOption("I", "include", "include directory", 1, 3), ListOption("t", "tags", "tags", ",", 0, 4)),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		include  []string
+		tags     []string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool", "-I", "a"}, []string{"a"}, []string{}, Unspecified, "", 0},
+		{[]string{"tool", "-Ib", "--include=a", "-I", "c"}, []string{"b", "a", "c"}, []string{}, Unspecified, "", 0},
+		{[]string{"tool", "-I", "a", "--tags", "x,y", "-tz", "--tags="}, []string{"a"}, []string{"x", "y", "z", ""}, Unspecified, "", 0},
+		{[]string{"tool", "-I", "a,b"}, []string{"a,b"}, []string{}, Unspecified, "", 0},
+		{[]string{"tool"}, nil, nil, TooFewValues, "", 1},
+		{[]string{"tool", "-t", "x"}, nil, nil, TooFewValues, "", 3},
+		{[]string{"tool", "-I", "a", "-I", "b", "-I", "c", "-I", "d"}, nil, nil, TooManyValues, "d", 8},
+		{[]string{"tool", "-I", "a", "-t", "w,x,y", "--tags=z,zz"}, nil, nil, TooManyValues, "z,zz", 5},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if include := ctx.OptionValues("I"); strings.Join(include, "|") != strings.Join(test.include, "|") {
+			t.Errorf("%v: include is %q, want %q", test.args, include, test.include)
+		}
+		if tags := ctx.OptionValues("tags"); len(tags) != len(test.tags) || strings.Join(tags, "|") != strings.Join(test.tags, "|") {
+			t.Errorf("%v: tags are %q, want %q", test.args, tags, test.tags)
+		}
+		if last := test.include[len(test.include)-1]; ctx.Option("include") != last {
+			t.Errorf("%v: option is %q, want the last value %q", test.args, ctx.Option("include"), last)
+		}
+	}
+}
+
+func TestMapOptions(t *testing.T) {
+	app := func(duplicates DuplicateKeys) func(Implementation) Application {
+		return func(implementation Implementation) Application {
+			return Simple("tool", "a tool", Statements(), Options(MapOption("D", "define", "a definition", duplicates)),
+				Flags(), Arguments(), NoVariadic(), implementation)
+		}
+	}
+	tests := []struct {
+		duplicates DuplicateKeys
+		args       []string
+		entries    map[string]string
+		kind       ErrorKind
+		token      string
+		position   int
+	}{
+		{RejectDuplicateKeys, []string{"tool"}, map[string]string{}, Unspecified, "", 0},
+		{RejectDuplicateKeys, []string{"tool", "-D", "env=prod", "--define=url=a=b", "-Dempty="}, map[string]string{"env": "prod", "url": "a=b", "empty": ""}, Unspecified, "", 0},
+		{RejectDuplicateKeys, []string{"tool", "-D", "env"}, nil, InvalidValue, "env", 2},
+		{RejectDuplicateKeys, []string{"tool", "--define", "=prod"}, nil, InvalidValue, "=prod", 2},
+		{RejectDuplicateKeys, []string{"tool", "-D", "env=dev", "-D", "env=prod"}, nil, DuplicateKey, "env=prod", 4},
+		{LastKeyWins, []string{"tool", "-D", "env=dev", "-D", "env=prod"}, map[string]string{"env": "prod"}, Unspecified, "", 0},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app(test.duplicates), nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		entries := ctx.OptionMap("D")
+		if len(entries) != len(test.entries) {
+			t.Errorf("%v: entries are %q, want %q", test.args, entries, test.entries)
+		}
+		for key, value := range test.entries {
+			if entries[key] != value {
+				t.Errorf("%v: entries are %q, want %q", test.args, entries, test.entries)
+			}
+		}
+	}
+}
+
+func TestOptionalOptions(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(OptionalOption("c", "color", "colorize the output", "WHEN", "always", "auto")),
+			Flags(), Arguments(Argument("FILE", "a file")), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		color    string
+		file     string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool", "a.txt"}, "auto", "a.txt", Unspecified, "", 0},
+		{[]string{"tool", "--color", "a.txt"}, "always", "a.txt", Unspecified, "", 0},
+		{[]string{"tool", "-c", "never"}, "always", "never", Unspecified, "", 0},
+		{[]string{"tool", "--color=never", "a.txt"}, "never", "a.txt", Unspecified, "", 0},
+		{[]string{"tool", "a.txt", "-cnever"}, "never", "a.txt", Unspecified, "", 0},
+		{[]string{"tool", "-c=never", "a.txt"}, "never", "a.txt", Unspecified, "", 0},
+		{[]string{"tool", "--color"}, "", "", MissingArgument, "", 2},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			continue
+		}
+		if color, file := ctx.Option("color"), ctx.Argument("FILE"); color != test.color || file != test.file {
+			t.Errorf("%v: color is %q and file is %q, want %q and %q", test.args, color, file, test.color, test.file)
+		}
+	}
+}
+
+func TestEnumOptions(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(EnumOption("f", "format", "output format", []string{"json", "yaml", "table"}, "table")),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		format   string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, "table", Unspecified, "", 0},
+		{[]string{"tool", "-f", "json"}, "json", Unspecified, "", 0},
+		{[]string{"tool", "--format=yaml"}, "yaml", Unspecified, "", 0},
+		{[]string{"tool", "--format", "xml"}, "", InvalidValue, "xml", 2},
+		{[]string{"tool", "-fJSON"}, "", InvalidValue, "JSON", 1},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			if parse != nil && !strings.Contains(parse.Error(), "one of (json, yaml, table) is expected") {
+				t.Errorf("%v: choices are not listed by %q", test.args, parse.Error())
+			}
+			continue
+		}
+		if format := ctx.Option("format"); format != test.format {
+			t.Errorf("%v: format is %q, want %q", test.args, format, test.format)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OptionalArgument represents an Argument which can be omitted by the end user, in
+// such case value is used as its default, and returned by cli.Context.Argument(name).
+// It is printed as [NAME] in the usage line, optional arguments must come after all
+// required ones, see cli.Arguments.
+//
+// # Panic when:
+//   - name is empty.
+func OptionalArgument(name, description, value string) optionalArgument {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		panic("cli.OptionalArgument: name cannot be empty")
+	}
+	return optionalArgument{
+		argument: Argument(name, description),
+		value:    value,
+	}
+}
+
+type optionalArgument struct {
+	argument
+	value string
+}
+
+func (a optionalArgument) Arg() string {
+	return fmt.Sprintf("[%s]", a.name)
+}
+
+func (a optionalArgument) Value() string {
+	return a.value
+}
+
+func (a optionalArgument) Optional() bool {
+	return true
+}
+
+func (a optionalArgument) Extract(namedArgs map[string]string, values map[string]any, args []string) ([]string, error) {
+	if len(args) == 0 {
+		namedArgs[a.name] = a.value
+		return args, nil
+	}
+	return a.argument.Extract(namedArgs, values, args)
+}
+
+func (a optionalArgument) String(width int) string {
+	if a.value == "" {
+		return a.argument.String(width)
+	}
+	return fmt.Sprintf("  %-[1]*s  %s (%s)\n", width, a.name, a.description, a.value)
+}
@@ -0,0 +1,92 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// OptionalOption represents an Option which value is optional, mirroring
+// (ls --color[=WHEN]), see cli.Option.
+//
+// When the end user gives the option without a value (--color or -c) implicit
+// is used, while an explicit value must be attached to the name (--color=never
+// or -cnever), a separate argv item is never consumed as a value. When the option
+// is not given at all, value is used as the default.
+//
+// placeholder names the value in the Options section e.g. WHEN for --color[=WHEN].
+//
+// # Panic when:
+//   - placeholder is empty.
+//   - see cli.Option.
+func OptionalOption(sname, lname, description, placeholder, implicit, value string) optionalOption {
+	placeholder = strings.TrimSpace(placeholder)
+	if placeholder == "" {
+		panic("cli.OptionalOption: placeholder cannot be empty")
+	}
+	return optionalOption{
+		option:      Option(sname, lname, description, value),
+		placeholder: placeholder,
+		implicit:    implicit,
+	}
+}
+
+type optionalOption struct {
+	option
+	placeholder string
+	implicit    string
+}
+
+func (o optionalOption) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	if len(args) < 1 {
+		return args, nil
+	}
+	if o.lname != "" && args[0] == "--"+o.lname { // --lname
+		o.set(opts, o.implicit)
+		return args[1:], nil
+	}
+	if o.sname != "" && args[0] == "-"+o.sname { // -s
+		o.set(opts, o.implicit)
+		return args[1:], nil
+	}
+	// only attached values are left e.g. --lname=value or -svalue
+	rest, err := o.option.Extract(opts, values, args[:1])
+	if err != nil || len(rest) != 0 {
+		return args, err
+	}
+	return args[1:], nil
+}
+
+func (o optionalOption) Kind() api.Kind {
+	return api.Kind{Attached: true, Implicit: o.implicit}
+}
+
+func (o optionalOption) Label() string {
+	if o.lname == "" {
+		return ""
+	}
+	return fmt.Sprintf("--%s[=%s]", o.lname, o.placeholder)
+}
+
+func (o optionalOption) String(width int) string {
+	note := fmt.Sprintf("(%s when %s is omitted)", o.implicit, o.placeholder)
+	if o.value != "" {
+		note = fmt.Sprintf("(%s) %s", o.value, note)
+	}
+	return o.render(width, o.Label(), note)
+}
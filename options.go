@@ -15,6 +15,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"github.com/begopher/cli/internal/api"
 	"strings"
@@ -47,8 +48,8 @@ func Options(opts ...api.Option) options {
 			msg := fmt.Sprintf("cli.Options: %s is duplicated", option.LName())
 			panic(msg)
 		}
-		if width < len(name) {
-			width = len(name)
+		if label := option.Label(); width < len(label) {
+			width = len(label)
 		}
 	}
 	return options{
@@ -62,23 +63,62 @@ type options struct {
 	width int
 }
 
-func (o options) Extract(to map[string]string, args []string) []string {
+func (o options) Extract(to map[string]string, values map[string]any, args []string) ([]string, error) {
 	length := len(args)
 	for _, opt := range o.opts {
-		args = opt.Extract(to, args)
+		var err error
+		if args, err = opt.Extract(to, values, args); err != nil {
+			return args, err
+		}
 		if length != len(args) {
-			break
+			return args, nil
 		}
 	}
 	//o.setDefault(to)
-	return args
+	return args, nil
+
+}
+
+func (o options) Default(to map[string]string, values map[string]any) {
+	for _, opt := range o.opts {
+		opt.Default(to, values)
+	}
+}
 
+// Resolve sets the options which are not given on the command line from sources,
+// see cli.Env and cli.Config.
+func (o options) Resolve(path []string, sources api.Sources, to map[string]string, values map[string]any) error {
+	for _, opt := range o.opts {
+		if err := resolveOption(path, sources, opt, to, values); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (o options) Default(to map[string]string) {
+// Validate returns the first error reported by options, except missing required
+// options which are reported all together.
+func (o options) Validate(from map[string]string, values map[string]any) error {
+	var missing *ParseError
 	for _, opt := range o.opts {
-		opt.Default(to)
+		err := opt.Validate(from, values)
+		if err == nil {
+			continue
+		}
+		var parse *ParseError
+		if !errors.As(err, &parse) || parse.Kind != MissingOption {
+			return err
+		}
+		if missing == nil {
+			missing = parse
+			continue
+		}
+		missing.Names = append(missing.Names, parse.Names...)
+	}
+	if missing != nil {
+		return missing
 	}
+	return nil
 }
 
 func (o options) Names() []string {
@@ -96,6 +136,10 @@ func (o options) Names() []string {
 	return names
 }
 
+func (o options) List() []api.Option {
+	return o.opts
+}
+
 func (o options) Count() int {
 	return len(o.opts)
 }
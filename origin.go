@@ -0,0 +1,42 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+// Origin tells where the value of an option or flag came from, it can be
+// accessed by cli.Context.Origin(name).
+type Origin int
+
+const (
+	// FromDefault is the origin of values set by the declared default value.
+	FromDefault Origin = iota
+	// FromCommandLine is the origin of values given by the end user in argv.
+	FromCommandLine
+	// FromEnv is the origin of values read from environment variables, see cli.Env.
+	FromEnv
+	// FromConfig is the origin of values read from the configuration file, see cli.Config.
+	FromConfig
+)
+
+func (o Origin) String() string {
+	switch o {
+	case FromCommandLine:
+		return "command line"
+	case FromEnv:
+		return "environment"
+	case FromConfig:
+		return "config file"
+	}
+	return "default"
+}
@@ -44,6 +44,7 @@ func Parent(name, description string, statement Statement, options api.Options,
 	if flags == nil {
 		panic("cli.Parent: flags cannot be nil")
 	}
+	verify("cli.Parent", statement, options, flags)
 	cmds := commands(manyCmds)
 	namespaces := cmds.Namespace()
 	if err := namespaces.Add(name); err != nil {
@@ -87,9 +88,12 @@ func (p parent) Description() string {
 	return p.description
 }
 
-func (p parent) Exec(path []string, options map[string]string, flags map[string]bool, args []string) (bool, error) {
+func (p parent) Exec(path []string, sources api.Sources, options map[string]string, flags map[string]bool, values map[string]any, args []string) (bool, error) {
 	path = append(path, p.name)
 	fullPath := strings.Join(path, " ")
+	usage := func(summaries ...string) string {
+		return p.usage(fullPath, summaries...)
+	}
 	if len(args) == 0 {
 		return false, nil
 	}
@@ -97,54 +101,77 @@ func (p parent) Exec(path []string, options map[string]string, flags map[string]
 		return false, nil
 	}
 	args = args[1:]
-	args = p.extract(options, flags, args)
-	p.options.Default(options)
-	p.flags.Default(flags)
+	args, err := p.extract(options, flags, values, args)
+	if err != nil {
+		return false, failure(path, err, usage)
+	}
+	if err := p.options.Resolve(path, sources, options, values); err != nil {
+		return false, failure(path, err, usage)
+	}
+	if err := p.flags.Resolve(path, sources, flags, values); err != nil {
+		return false, failure(path, err, usage)
+	}
+	// constraints count every value which is not a default value
+	set := given(options, flags)
+	p.options.Default(options, values)
+	p.flags.Default(flags, values)
 	if len(args) == 0 {
-		return false, fmt.Errorf(p.usage(fullPath, "Error: no command was selected"))
+		return false, parseFailure(path, parseError(NoCommandSelected, args), usage)
 	}
 	if args[0] == "--help" {
-		return false, fmt.Errorf(p.usage(fullPath))
+		return false, helpRequested(path, p.usage(fullPath))
 	}
 	if strings.HasPrefix(args[0], "-") {
 		if p.options.Has(args[0]) {
-			msg := fmt.Sprintf("Error: missing value for %s option (e.g. %[1]s value)", args[0])
-			return false, fmt.Errorf(p.usage(fullPath, msg))
+			return false, parseFailure(path, parseError(MissingOptionValue, args), usage)
 		}
 		if p.options.Count() > 0 && p.flags.Count() > 0 {
-			msg := fmt.Sprintf("Error: unknown option or flag (%s)", args[0])
-			return false, fmt.Errorf(p.usage(fullPath, msg))
+			return false, parseFailure(path, parseError(UnknownOptionOrFlag, args), usage)
 		}
 		if p.options.Count() > 0 {
-			msg := fmt.Sprintf("Error: unknown option (%s)", args[0])
-			return false, fmt.Errorf(p.usage(fullPath, msg))
+			return false, parseFailure(path, parseError(UnknownOption, args), usage)
 		}
 		if p.flags.Count() > 0 {
-			msg := fmt.Sprintf("Error: unknown flag (%s)", args[0])
-			return false, fmt.Errorf(p.usage(fullPath, msg))
+			return false, parseFailure(path, parseError(UnknownFlag, args), usage)
 		}
-		msg := fmt.Sprintf("Error: unknown command (%s)", args[0])
-		return false, fmt.Errorf(p.usage(fullPath, msg))
+		return false, parseFailure(path, parseError(UnknownCommand, args), usage)
 	}
-	ok, err := p.commands.Exec(path, options, flags, args)
+	// checked by the selected command, so its --help is not blocked by missing options
+	sources.Defer(func() error {
+		if err := p.options.Validate(options, values); err != nil {
+			return failure(path, err, usage)
+		}
+		if err := check(p.statement, set); err != nil {
+			return failure(path, err, usage)
+		}
+		return nil
+	})
+	ok, err := p.commands.Exec(path, sources, options, flags, values, args)
 	if err != nil {
 		return ok, err
 	}
 	if ok {
 		return ok, err
 	}
-	msg := fmt.Sprintf("Error: unknown command (%s)", args[0])
-	return false, fmt.Errorf(p.usage(fullPath, msg))
+	return false, parseFailure(path, parseError(UnknownCommand, args), usage)
 }
 
-func (p parent) extract(options map[string]string, flags map[string]bool, args []string) []string {
-	length := len(args)
-	args = p.options.Extract(options, args)
-	args = p.flags.Extract(flags, args)
-	if length != len(args) {
-		args = p.extract(options, flags, args)
+func (p parent) extract(options map[string]string, flags map[string]bool, values map[string]any, args []string) ([]string, error) {
+	length, first := len(args), ""
+	if length > 0 {
+		first = args[0]
 	}
-	return args
+	args, err := p.options.Extract(options, values, args)
+	if err != nil {
+		return args, err
+	}
+	if args, err = p.flags.Extract(flags, values, args); err != nil {
+		return args, err
+	}
+	if length != len(args) || (length > 0 && first != args[0]) { // grouped flags are consumed one by one
+		return p.extract(options, flags, values, args)
+	}
+	return args, nil
 }
 
 func (p parent) usage(path string, errors ...string) string {
@@ -188,3 +215,31 @@ func (p parent) String(width int) string {
 func (p parent) Help() string {
 	return p.usage(p.name)
 }
+
+func (p parent) Usage(path string) string {
+	return p.usage(path)
+}
+
+func (p parent) Statement(path string) string {
+	return p.statement.String(path)
+}
+
+func (p parent) Options() api.Options {
+	return p.options
+}
+
+func (p parent) Flags() api.Flags {
+	return p.flags
+}
+
+func (p parent) Arguments() api.Arguments {
+	return Arguments()
+}
+
+func (p parent) Variadic() api.Variadic {
+	return NoVariadic()
+}
+
+func (p parent) Commands() []api.Command {
+	return p.commands.List()
+}
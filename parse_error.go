@@ -0,0 +1,148 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError describes a mistake made by the end user while invoking
+// the application, e.g. unknown option, missing argument or unexpected value.
+// The human readable message is rendered from its fields by Error().
+//
+// ParseError is never returned directly by Application.Run, it is wrapped by
+// *cli.UsageError and can be accessed by errors.As(err, &parseError).
+type ParseError struct {
+	Kind ErrorKind
+	// Token is the offending argv item, it is empty when the mistake is
+	// about something missing e.g. a command or an argument.
+	Token string
+	// Position is the index of Token in the argv given to Application.Run,
+	// when Token is empty it is the index where the missing item was expected.
+	// It is -1 when Token is not given in argv e.g. it is read from an environment variable.
+	Position int
+	// Path is the path of the command which rejected the given input.
+	Path []string
+	// Names holds the names of the missing arguments, required options or
+	// options and flags of the violated constraint.
+	Names []string
+	// Values holds all unexpected values, including Token.
+	Values []string
+	// Option is the name of the option or flag (as given by the end user e.g. --count),
+	// or the name of the argument, which the error is attributed to.
+	Option string
+	// Err is the reason why the value of Option is rejected.
+	Err error
+	// remains is the number of argv items starting from Token, which is
+	// used by Application.Run to resolve Position.
+	remains int
+}
+
+func (e *ParseError) Error() string {
+	switch e.Kind {
+	case UnknownOption:
+		return fmt.Sprintf("unknown option (%s)", e.Token)
+	case UnknownFlag:
+		return fmt.Sprintf("unknown flag (%s)", e.Token)
+	case UnknownOptionOrFlag:
+		return fmt.Sprintf("unknown option or flag (%s)", e.Token)
+	case MissingOptionValue:
+		return fmt.Sprintf("missing value for %s option (e.g. %[1]s value)", e.Token)
+	case MissingArgument:
+		if len(e.Names) == 1 {
+			return fmt.Sprintf("missing value for (%s) argument", e.Names[0])
+		}
+		return fmt.Sprintf("missing values for (%s) arguments", strings.Join(e.Names, ", "))
+	case UnexpectedValue:
+		if len(e.Values) > 1 {
+			return fmt.Sprintf("unexpected values (%s)", strings.Join(e.Values, ", "))
+		}
+		return fmt.Sprintf("unexpected value (%s)", e.Token)
+	case MissingDoubleDash:
+		return fmt.Sprintf("double hyphens (--) is missing before (%s)", e.Token)
+	case UnknownCommand:
+		return fmt.Sprintf("unknown command (%s)", e.Token)
+	case NoCommandSelected:
+		return "no command was selected"
+	case InvalidValue:
+		return fmt.Sprintf("invalid value (%s) for %s: %s", e.Token, e.Option, e.Err)
+	case TooManyValues:
+		return fmt.Sprintf("too many values for %s: %s", e.subject(), e.Err)
+	case TooFewValues:
+		return fmt.Sprintf("too few values for %s: %s", e.subject(), e.Err)
+	case MissingOption:
+		if len(e.Names) == 1 {
+			return fmt.Sprintf("missing required option (%s)", e.Names[0])
+		}
+		return fmt.Sprintf("missing required options (%s)", strings.Join(e.Names, ", "))
+	case ConstraintViolation:
+		return e.Err.Error()
+	case DuplicateKey:
+		return fmt.Sprintf("duplicate entry (%s) for %s option: %s", e.Token, e.Option, e.Err)
+	}
+	return fmt.Sprintf("invalid input (%s)", e.Token)
+}
+
+// subject returns how Option is referred to in messages e.g. --tag option or FILE.
+func (e *ParseError) subject() string {
+	if strings.HasPrefix(e.Option, "-") {
+		return e.Option + " option"
+	}
+	return e.Option
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseError creates ParseError of the given kind, where args are the
+// remaining argv items starting from the offending token (if any).
+func parseError(kind ErrorKind, args []string) *ParseError {
+	var token string
+	if len(args) > 0 {
+		token = args[0]
+	}
+	return &ParseError{
+		Kind:    kind,
+		Token:   token,
+		remains: len(args),
+	}
+}
+
+// valueError creates ParseError of the given kind for the value (text) given to
+// the option (name) by args[0], where rest is what is left after extracting them.
+func valueError(kind ErrorKind, args, rest []string, name, text string, err error) *ParseError {
+	parse := parseError(kind, args[len(args)-len(rest)-1:])
+	parse.Token = text
+	parse.Option = name
+	parse.Err = err
+	return parse
+}
+
+// locate resolves the position of the offending token (if err carries one)
+// within argv of the given length.
+func locate(err error, argc int) error {
+	var usage *UsageError
+	if !errors.As(err, &usage) {
+		return err
+	}
+	var parse *ParseError
+	if errors.As(usage.Err, &parse) && parse.remains >= 0 {
+		parse.Position = argc - parse.remains
+	}
+	return err
+}
@@ -0,0 +1,131 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// RepeatableOption represents an Option which may be given more than once
+// by the end user (e.g. -I dir1 -I dir2), where all given values are collected
+// in the same order, see cli.Option.
+//
+// The values can be accessed by cli.Context.OptionValues("key"), while
+// cli.Context.Option("key") returns the last given value.
+//
+// min is the least number of values which must be given, while max is the
+// most number of values which can be given, zero max means no upper limit.
+// Violating min or max is reported as a usage error.
+//
+// # Panic when:
+//   - min or max is negative.
+//   - max is less than min (unless max is zero).
+//   - see cli.Option.
+func RepeatableOption(sname, lname, description string, min, max int) repeatableOption {
+	if min < 0 || max < 0 {
+		panic("cli.RepeatableOption: min and max cannot be negative")
+	}
+	if max != 0 && max < min {
+		panic("cli.RepeatableOption: max cannot be less than min")
+	}
+	return repeatableOption{
+		option: Option(sname, lname, description, ""),
+		min:    min,
+		max:    max,
+	}
+}
+
+// ListOption is a RepeatableOption where each given value is split by separator
+// into many values e.g. --tags a,b,c -t d is equivalent to -t a -t b -t c -t d.
+// min and max are applied to the values after splitting.
+//
+// # Panic when:
+//   - separator is empty.
+//   - see cli.RepeatableOption.
+func ListOption(sname, lname, description, separator string, min, max int) repeatableOption {
+	if separator == "" {
+		panic("cli.ListOption: separator cannot be empty")
+	}
+	option := RepeatableOption(sname, lname, description, min, max)
+	option.separator = separator
+	return option
+}
+
+type repeatableOption struct {
+	option
+	separator string
+	min       int
+	max       int
+}
+
+func (o repeatableOption) Kind() api.Kind {
+	return api.Kind{Repeatable: true, Min: o.min, Max: o.max, Separator: o.separator}
+}
+
+func (o repeatableOption) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	name, text, rest, ok := o.match(args)
+	if !ok {
+		return args, nil
+	}
+	given := []string{text}
+	if o.separator != "" {
+		given = strings.Split(text, o.separator)
+	}
+	collected, _ := values[o.key()].([]string)
+	collected = append(collected, given...)
+	if o.max != 0 && len(collected) > o.max {
+		return args, valueError(TooManyValues, args, rest, name, text, fmt.Errorf("at most %d can be given", o.max))
+	}
+	o.set(opts, text)
+	o.store(values, collected)
+	return rest, nil
+}
+
+func (o repeatableOption) Default(opts map[string]string, values map[string]any) {
+	o.option.Default(opts, values)
+	if _, ok := values[o.key()]; !ok {
+		o.store(values, []string{})
+	}
+}
+
+func (o repeatableOption) Validate(opts map[string]string, values map[string]any) error {
+	collected, _ := values[o.key()].([]string)
+	if len(collected) < o.min {
+		err := parseError(TooFewValues, nil)
+		err.Option = o.display()
+		err.Err = fmt.Errorf("at least %d must be given", o.min)
+		return err
+	}
+	return nil
+}
+
+func (o repeatableOption) String(width int) string {
+	note := "repeatable"
+	if o.separator != "" {
+		note = fmt.Sprintf("repeatable, separated by %q", o.separator)
+	}
+	switch {
+	case o.min > 0 && o.max > 0:
+		note = fmt.Sprintf("%s, %d to %d values", note, o.min, o.max)
+	case o.min > 0:
+		note = fmt.Sprintf("%s, at least %d", note, o.min)
+	case o.max > 0:
+		note = fmt.Sprintf("%s, at most %d", note, o.max)
+	}
+	return o.render(width, o.Label(), fmt.Sprintf("(%s)", note))
+}
@@ -0,0 +1,65 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// Required marks the given option as mandatory, the end user must give its value,
+// otherwise a usage error listing all missing required options of the command is
+// reported before the command is executed. The default value of the option (if any)
+// is never used.
+//
+//	cli.Options(
+//		cli.Required(cli.Option("r", "region", "deployment region", "")),
+//	)
+//
+// # Panic when:
+//   - option is nil.
+func Required(option api.Option) required {
+	if option == nil {
+		panic("cli.Required: option cannot be nil")
+	}
+	return required{option}
+}
+
+type required struct {
+	api.Option
+}
+
+func (r required) Default(opts map[string]string, values map[string]any) {
+	// the value must be given by the end user
+}
+
+func (r required) Validate(opts map[string]string, values map[string]any) error {
+	if _, ok := opts[optionKey(r.SName(), r.LName())]; !ok {
+		err := parseError(MissingOption, nil)
+		err.Names = []string{optionDisplay(r.SName(), r.LName())}
+		return err
+	}
+	return r.Option.Validate(opts, values)
+}
+
+func (r required) Required() bool {
+	return true
+}
+
+func (r required) String(width int) string {
+	line := strings.TrimRight(r.Option.String(width), " \n")
+	return line + " (required)\n"
+}
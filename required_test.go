@@ -0,0 +1,96 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRequiredOptionsDoNotBlockHelpOfSubCommands(t *testing.T) {
+	nop := Function(func(ctx Context) error { return nil })
+	add := Command("add", "add a remote", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nop)
+	remote := Parent("remote", "manage remotes", Statements(),
+		Options(Required(Option("", "name", "remote name", ""))), Flags(), add)
+	app := Nested("tool", "a tool", Statements(),
+		Options(Required(Option("r", "region", "deployment region", ""))), Flags(),
+		Group("Commands", remote))
+	tests := []struct {
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{[]string{"tool", "--help"}, ExitSuccess, "Usage: tool ", ""},
+		{[]string{"tool", "remote", "--help"}, ExitSuccess, "Usage: tool remote ", ""},
+		{[]string{"tool", "remote", "add", "--help"}, ExitSuccess, "Usage: tool remote add", ""},
+		{[]string{"tool", "remote", "add"}, ExitUsage, "", "--region"},
+		{[]string{"tool", "-r", "eu", "remote", "add"}, ExitUsage, "", "--name"},
+		{[]string{"tool", "-r", "eu", "remote", "--name", "x", "add"}, ExitSuccess, "", ""},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := Execute(app, test.args, &stdout, &stderr)
+		if code != test.code {
+			t.Errorf("%v: exit code is %d, want %d (%s)", test.args, code, test.code, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), test.stdout) {
+			t.Errorf("%v: stdout %q does not contain %q", test.args, stdout.String(), test.stdout)
+		}
+		if !strings.Contains(stderr.String(), test.stderr) {
+			t.Errorf("%v: stderr %q does not contain %q", test.args, stderr.String(), test.stderr)
+		}
+	}
+}
+
+func TestValidatedOptions(t *testing.T) {
+	lower := func(value string) error {
+		if strings.ToLower(value) != value {
+			return errors.New("lower case is expected")
+		}
+		return nil
+	}
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(Validated(Option("b", "bucket", "bucket name", "logs"), lower)),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args     []string
+		bucket   string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool"}, "logs", Unspecified, "", 0},
+		{[]string{"tool", "-b", "data"}, "data", Unspecified, "", 0},
+		{[]string{"tool", "--bucket", "Data"}, "", InvalidValue, "Data", 2},
+		{[]string{"tool", "-bData"}, "", InvalidValue, "Data", 1},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			if parse != nil && (parse.Option == "" || !strings.Contains(parse.Error(), "lower case is expected")) {
+				t.Errorf("%v: error is not attributed to the option: %q of %q", test.args, parse.Error(), parse.Option)
+			}
+			continue
+		}
+		if bucket := ctx.Option("bucket"); bucket != test.bucket {
+			t.Errorf("%v: bucket is %q, want %q", test.args, bucket, test.bucket)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+)
+
+// Requires is a Statement which requires the end user to set all of the required
+// options/flags whenever the named option/flag is set e.g. (--tag requires --push),
+// see cli.ExactlyOneOf. A flag which is explicitly lowered (e.g. --no-push) neither
+// sets the named flag nor satisfies a required one.
+func Requires(name string, required ...string) rule {
+	names := constrained("cli.Requires", 2, append([]string{name}, required...))
+	return rule{
+		names: names,
+		text:  fmt.Sprintf("%s requires %s to be given", hyphenate(names[:1]), hyphenate(names[1:])),
+		violated: func(given map[string]bool) bool {
+			return given[names[0]] && raised(given, names[1:]) != len(names)-1
+		},
+	}
+}
@@ -15,6 +15,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/begopher/cli/internal/api"
@@ -37,23 +38,45 @@ func Simple(name, description string, statement Statement, options api.Options,
 }
 
 type simpleApp struct {
-	command api.Command
+	command command
 }
 
 func (s simpleApp) Run(args []string) error {
+	return s.start(args, sources())
+}
+
+func (s simpleApp) start(args []string, sources _sources) error {
+	return locate(s.run(args, sources), len(args))
+}
+
+func (s simpleApp) run(args []string, sources _sources) error {
 	if len(args) == 0 {
-		return fmt.Errorf(s.command.Help())
+		path := []string{s.command.Name()}
+		err := errors.New("application name is missing")
+		return usageError(path, Unspecified, "", err, s.command.usage(s.command.name, summary(err)))
 	}
 	args[0] = removeAbsolutePath(args[0])
 	options := make(map[string]string, 0)
 	flags := make(map[string]bool, 0)
+	values := make(map[string]any, 0)
 	path := make([]string, 0)
-	ok, err := s.command.Exec(path, options, flags, args)
+	ok, err := s.command.Exec(path, sources, options, flags, values, args)
 	if err != nil {
 		return err
 	}
 	if !ok {
-		return fmt.Errorf(s.command.Help())
+		path := []string{s.command.Name()}
+		err := fmt.Errorf("unexpected application name (%s)", args[0])
+		return usageError(path, Unspecified, args[0], err, s.command.usage(s.command.name, summary(err)))
 	}
 	return nil
 }
+
+func (s simpleApp) builtin(name, description string) runner {
+	s.command.statement = listed(s.command.statement, name, description)
+	return s
+}
+
+func (s simpleApp) tree() node {
+	return describe(nil, s.command)
+}
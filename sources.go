@@ -0,0 +1,285 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// sources returns the sources of values which are used by Application.Run,
+// when options and flags are not given on the command line.
+func sources() _sources {
+	return _sources{
+		env:      os.LookupEnv,
+		origins:  make(map[string]Origin),
+		deferred: new([]func() error),
+		stdout:   os.Stdout,
+	}
+}
+
+type _sources struct {
+	env     func(string) (string, bool)
+	config  map[string]any
+	origins map[string]Origin
+	// deferred are the checks of ancestors of the selected command, see Defer.
+	deferred *[]func() error
+	// stdout is where built-in commands (e.g. completion) print their output.
+	stdout io.Writer
+}
+
+func (s _sources) Env(name string) (string, bool) {
+	return s.env(name)
+}
+
+func (s _sources) Config(path []string, name string) ([]string, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	sections := make([]map[string]any, 0, 2)
+	if dotted, ok := s.config[strings.Join(path[1:], ".")].(map[string]any); ok && len(path) > 1 {
+		sections = append(sections, dotted)
+	}
+	nested := s.config
+	for _, step := range path[1:] {
+		nested, _ = nested[step].(map[string]any)
+	}
+	sections = append(sections, nested)
+	for _, section := range sections {
+		if value, ok := section[name]; ok && value != nil {
+			return texts(value), true
+		}
+	}
+	return nil, false
+}
+
+func (s _sources) Record(name string, origin int) {
+	s.origins[name] = Origin(origin)
+}
+
+func (s _sources) Origin(name string) int {
+	return int(s.origins[name])
+}
+
+func (s _sources) Defer(check func() error) {
+	*s.deferred = append(*s.deferred, check)
+}
+
+func (s _sources) Checks() error {
+	for _, check := range *s.deferred {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// texts returns the value(s) of a configuration entry as if they were given on
+// the command line.
+func texts(value any) []string {
+	switch value := value.(type) {
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			values = append(values, texts(item)...)
+		}
+		return values
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		entries := make([]string, 0, len(value))
+		for _, key := range keys {
+			for _, item := range texts(value[key]) {
+				entries = append(entries, key+"="+item)
+			}
+		}
+		return entries
+	case string:
+		return []string{value}
+	case json.Number:
+		return []string{value.String()}
+	case float64:
+		return []string{strconv.FormatFloat(value, 'f', -1, 64)}
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+// runner is implemented by applications which can run with sources other
+// than the default ones.
+type runner interface {
+	Application
+	start(args []string, sources _sources) error
+	tree() node
+	// builtin returns the runner with the built-in command (name) listed in its
+	// root usage, see cli.Completion.
+	builtin(name, description string) runner
+}
+
+// listed returns statement followed by the usage of the built-in command (name).
+func listed(statement Statement, name, description string) Statement {
+	lines := []string{"Built-in commands:", fmt.Sprintf("  %s  %s", name, description)}
+	if !statement.Empty() {
+		lines = append([]string{""}, lines...)
+	}
+	return Statements(statement, Text(lines...))
+}
+
+// runnable returns app as a runner, it panics when app is not created by this package.
+func runnable(caller string, app Application) runner {
+	if app == nil {
+		panic(caller + ": app cannot be nil")
+	}
+	r, ok := app.(runner)
+	if !ok {
+		panic(caller + ": app must be created by cli.Nested or cli.Simple")
+	}
+	return r
+}
+
+// resolveOption sets the value of option from its environment variable or the
+// configuration file, unless the option is given on the command line.
+func resolveOption(path []string, sources api.Sources, option api.Option, to map[string]string, values map[string]any) error {
+	key, arg := option.LName(), "--"+option.LName()+"="
+	if key == "" {
+		key, arg = option.SName(), "-"+option.SName()+"="
+	}
+	if _, ok := to[key]; ok {
+		record(sources, FromCommandLine, option.SName(), option.LName())
+		return nil
+	}
+	if name := option.Env(); name != "" {
+		if value, ok := sources.Env(name); ok {
+			if _, err := option.Extract(to, values, []string{arg + value}); err != nil {
+				return external(err, "$"+name)
+			}
+			record(sources, FromEnv, option.SName(), option.LName())
+			return nil
+		}
+	}
+	entries, ok := sources.Config(path, key)
+	if !ok {
+		return nil
+	}
+	for _, value := range entries {
+		if _, err := option.Extract(to, values, []string{arg + value}); err != nil {
+			return external(err, entry(path, key))
+		}
+	}
+	record(sources, FromConfig, option.SName(), option.LName())
+	return nil
+}
+
+// resolveFlag sets the value of flag from its environment variable or the
+// configuration file, unless the flag is given on the command line.
+func resolveFlag(path []string, sources api.Sources, flag api.Flag, to map[string]bool, values map[string]any) error {
+	key := flag.LName()
+	if key == "" {
+		key = flag.SName()
+	}
+	if _, ok := to[key]; ok {
+		record(sources, FromCommandLine, flag.SName(), flag.LName())
+		return nil
+	}
+	if name := flag.Env(); name != "" {
+		if value, ok := sources.Env(name); ok {
+			if err := raise(flag, to, values, value); err != nil {
+				return external(err, "$"+name)
+			}
+			record(sources, FromEnv, flag.SName(), flag.LName())
+			return nil
+		}
+	}
+	entries, ok := sources.Config(path, key)
+	if !ok {
+		return nil
+	}
+	for _, value := range entries {
+		if err := raise(flag, to, values, value); err != nil {
+			return external(err, entry(path, key))
+		}
+	}
+	record(sources, FromConfig, flag.SName(), flag.LName())
+	return nil
+}
+
+// assigner is implemented by flags whose value in environment variables and config
+// files is not a boolean e.g. cli.CountFlag accepts the count.
+type assigner interface {
+	assign(to map[string]bool, values map[string]any, value string) error
+}
+
+// raise raises flag when value is true, otherwise it is lowered, unless flag
+// assigns value by itself (see assigner).
+func raise(flag api.Flag, to map[string]bool, values map[string]any, value string) error {
+	if assigner, ok := flag.(assigner); ok {
+		return assigner.assign(to, values, value)
+	}
+	arg := "--" + flag.LName()
+	if flag.LName() == "" {
+		arg = "-" + flag.SName()
+	}
+	raised, err := strconv.ParseBool(value)
+	if err != nil {
+		parse := parseError(InvalidValue, []string{value})
+		parse.Err = errors.New("boolean is expected")
+		return parse
+	}
+	if raised {
+		_, err := flag.Extract(to, values, []string{arg})
+		return err
+	}
+	for _, key := range []string{flag.SName(), flag.LName()} {
+		if key != "" {
+			to[key] = false
+		}
+	}
+	return nil
+}
+
+func record(sources api.Sources, origin Origin, names ...string) {
+	for _, name := range names {
+		if name != "" {
+			sources.Record(name, int(origin))
+		}
+	}
+}
+
+// entry returns how the configuration entry of key is referred to in messages.
+func entry(path []string, key string) string {
+	return fmt.Sprintf("config (%s)", strings.Join(append(path[1:len(path):len(path)], key), "."))
+}
+
+// external attributes err (if it is *ParseError) to source e.g. $MYTOOL_REGION,
+// where the offending value has no position in argv.
+func external(err error, source string) error {
+	var parse *ParseError
+	if errors.As(err, &parse) {
+		parse.Option = source
+		parse.Position = -1
+		parse.remains = -1
+	}
+	return err
+}
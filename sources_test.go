@@ -0,0 +1,171 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSourcesPrecedenceAndConstraints(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(file, []byte(`{"service": "from-config"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		env     map[string]string
+		config  bool
+		args    []string
+		code    int
+		service string
+		origin  Origin
+	}{
+		{nil, false, []string{"deploy", "-s", "api"}, ExitSuccess, "api", FromCommandLine},
+		{map[string]string{"MYTOOL_SERVICE": "from-env"}, false, []string{"deploy", "-s", "api"}, ExitSuccess, "api", FromCommandLine},
+		{nil, true, []string{"deploy", "--service=api"}, ExitSuccess, "api", FromCommandLine},
+		// values of environment variables and config files are given by the end user
+		{map[string]string{"MYTOOL_SERVICE": "from-env"}, false, []string{"deploy"}, ExitSuccess, "from-env", FromEnv},
+		{map[string]string{"MYTOOL_SERVICE": "from-env"}, true, []string{"deploy"}, ExitSuccess, "from-env", FromEnv},
+		{nil, true, []string{"deploy"}, ExitSuccess, "from-config", FromConfig},
+		{map[string]string{"MYTOOL_SERVICE": "from-env"}, false, []string{"deploy", "--all"}, ExitUsage, "", FromDefault},
+		{nil, true, []string{"deploy", "--all"}, ExitUsage, "", FromDefault},
+		{nil, false, []string{"deploy"}, ExitUsage, "", FromDefault},
+		{nil, false, []string{"deploy", "--all", "-s", "api"}, ExitUsage, "", FromDefault},
+	}
+	for _, test := range tests {
+		var service string
+		var origin Origin
+		implementation := Function(func(ctx Context) error {
+			service, origin = ctx.Option("service"), ctx.Origin("service")
+			return nil
+		})
+		var app Application = Simple("deploy", "deploy services", Statements(ExactlyOneOf("all", "service")),
+			Options(Env("MYTOOL_").Option("SERVICE", Option("s", "service", "service name", ""))),
+			Flags(Flag("a", "all", "all services")), Arguments(), NoVariadic(), implementation)
+		if test.config {
+			app = Config(app, file, JSON())
+		}
+		app = Environment(app, func(name string) (string, bool) {
+			value, ok := test.env[name]
+			return value, ok
+		})
+		var stdout, stderr bytes.Buffer
+		code := Execute(app, test.args, &stdout, &stderr)
+		if code != test.code {
+			t.Errorf("%v %v: exit code is %d, want %d (%s)", test.env, test.args, code, test.code, stderr.String())
+			continue
+		}
+		if code == ExitUsage && !strings.Contains(stderr.String(), "Exactly one of (--all, --service)") {
+			t.Errorf("%v %v: stderr %q does not report the violated rule", test.env, test.args, stderr.String())
+		}
+		if service != test.service || origin != test.origin {
+			t.Errorf("%v %v: service is %q from %s, want %q from %s", test.env, test.args, service, origin, test.service, test.origin)
+		}
+	}
+}
+
+func TestCountFlagFromSources(t *testing.T) {
+	tests := []struct {
+		env    string
+		config string
+		args   []string
+		code   int
+		count  int
+	}{
+		{"", `{"verbose": 3}`, []string{"tool"}, ExitSuccess, 3},
+		{"", `{"verbose": true}`, []string{"tool"}, ExitSuccess, 1},
+		{"", `{"verbose": false}`, []string{"tool"}, ExitSuccess, 0},
+		{"2", `{"verbose": 3}`, []string{"tool"}, ExitSuccess, 2},
+		{"2", `{"verbose": 3}`, []string{"tool", "-v"}, ExitSuccess, 1},
+		{"", `{"verbose": -1}`, []string{"tool"}, ExitUsage, 0},
+		{"loud", `{}`, []string{"tool"}, ExitUsage, 0},
+	}
+	for _, test := range tests {
+		file := filepath.Join(t.TempDir(), "config.json")
+		if err := os.WriteFile(file, []byte(test.config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		var count int
+		implementation := Function(func(ctx Context) error {
+			count = ctx.Count("verbose")
+			return nil
+		})
+		app := Simple("tool", "a tool", Statements(), Options(),
+			Flags(Env("MYTOOL_").Flag("VERBOSE", CountFlag("v", "verbose", "verbosity level"))),
+			Arguments(), NoVariadic(), implementation)
+		app = Environment(Config(app, file, JSON()), func(name string) (string, bool) {
+			return test.env, name == "MYTOOL_VERBOSE" && test.env != ""
+		})
+		var stdout, stderr bytes.Buffer
+		code := Execute(app, test.args, &stdout, &stderr)
+		if code != test.code || count != test.count {
+			t.Errorf("env %q, config %s, %v: exit code is %d and count is %d, want %d and %d (%s)",
+				test.env, test.config, test.args, code, count, test.code, test.count, stderr.String())
+		}
+	}
+}
+
+func TestValidatedEnvironmentValues(t *testing.T) {
+	lower := func(value string) error {
+		if strings.ToLower(value) != value {
+			return errors.New("lower case is expected")
+		}
+		return nil
+	}
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(),
+			Options(Env("MYTOOL_").Option("BUCKET", Validated(Option("b", "bucket", "bucket name", "logs"), lower))),
+			Flags(), Arguments(), NoVariadic(), implementation)
+	}
+	ctx, parse := invoke(t, app, map[string]string{"MYTOOL_BUCKET": "data"}, "tool")
+	if parse != nil || ctx.Option("bucket") != "data" {
+		t.Errorf("valid environment value is rejected: %v", parse)
+	}
+	_, parse = invoke(t, app, map[string]string{"MYTOOL_BUCKET": "Data"}, "tool")
+	if parse == nil || parse.Kind != InvalidValue || parse.Token != "Data" || !strings.Contains(parse.Error(), "lower case is expected") {
+		t.Errorf("invalid environment value is not reported: %v", parse)
+	}
+}
+
+func TestMalformedConfigDoesNotBlockHelp(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(file, []byte(`{"verbose": `), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	nop := Function(func(ctx Context) error { return nil })
+	status := Command("status", "show the status", Statements(), Options(), Flags(Flag("v", "verbose", "verbose output")),
+		Arguments(), NoVariadic(), nop)
+	app := Config(Nested("tool", "a tool", Statements(), Options(), Flags(), Group("Commands", status)), file, JSON())
+	tests := []struct {
+		args   []string
+		code   int
+		stderr string
+	}{
+		{[]string{"tool", "--help"}, ExitSuccess, ""},
+		{[]string{"tool", "status", "--help"}, ExitSuccess, ""},
+		{[]string{"tool", "status"}, ExitFailure, "invalid config file (" + file + ")"},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := Execute(app, test.args, &stdout, &stderr)
+		if code != test.code || !strings.Contains(stderr.String(), test.stderr) {
+			t.Errorf("%v: exit code is %d and stderr is %q, want %d and %q", test.args, code, stderr.String(), test.code, test.stderr)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+)
+
+// SpecVersion is the version of the schema of cli.Spec, it is increased whenever
+// a field is renamed or removed, or its meaning is changed.
+const SpecVersion = 1
+
+// Spec is the public model of an application tree, see cli.Describe.
+type Spec struct {
+	Version int         `json:"version"`
+	Command CommandSpec `json:"command"`
+}
+
+// CommandSpec describes the application or one of its commands, where Usage is
+// the usage line without the Usage prefix and Statement is the statement text.
+type CommandSpec struct {
+	Name        string         `json:"name"`
+	Path        []string       `json:"path"`
+	Description string         `json:"description"`
+	Usage       string         `json:"usage"`
+	Statement   string         `json:"statement"`
+	Options     []OptionSpec   `json:"options"`
+	Flags       []FlagSpec     `json:"flags"`
+	Arguments   []ArgumentSpec `json:"arguments"`
+	Variadic    *VariadicSpec  `json:"variadic"`
+	Groups      []GroupSpec    `json:"groups"`
+}
+
+// GroupSpec describes a cli.Group, commands of a cli.Parent belong to a single
+// group named Commands.
+type GroupSpec struct {
+	Name     string        `json:"name"`
+	Commands []CommandSpec `json:"commands"`
+}
+
+// OptionSpec describes an option, where Label is the long name as it is printed
+// in the Options section e.g. --output=VALUE, and Default is the default value.
+// Type is the Go type of the converted value (e.g. int, time.Duration) or empty for
+// text. A repeatable option (see cli.RepeatableOption) accepts at least Min and at
+// most Max values (zero Max means no upper limit), split by Separator when it is
+// not empty, and Map is true when the values are key=value entries. Implicit is
+// the value of an optional option (see cli.OptionalOption) given alone.
+type OptionSpec struct {
+	Short       string   `json:"short"`
+	Long        string   `json:"long"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Default     string   `json:"default"`
+	Choices     []string `json:"choices"`
+	Required    bool     `json:"required"`
+	Env         string   `json:"env"`
+	Completed   bool     `json:"completed"`
+	Type        string   `json:"type"`
+	Repeatable  bool     `json:"repeatable"`
+	Min         int      `json:"min"`
+	Max         int      `json:"max"`
+	Separator   string   `json:"separator"`
+	Map         bool     `json:"map"`
+	Optional    bool     `json:"optional"`
+	Implicit    string   `json:"implicit"`
+}
+
+// FlagSpec describes a flag, where Label is the long name as it is printed in the
+// Flags section e.g. --[no-]color, Default is the value of the flag when it is not
+// given, and Count is true for cli.CountFlag.
+type FlagSpec struct {
+	Short       string `json:"short"`
+	Long        string `json:"long"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+	Negatable   bool   `json:"negatable"`
+	Count       bool   `json:"count"`
+	Env         string `json:"env"`
+}
+
+// ArgumentSpec describes a positional argument, where Default is the default value
+// of an optional argument.
+type ArgumentSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Optional    bool   `json:"optional"`
+	Default     string `json:"default"`
+	Completed   bool   `json:"completed"`
+}
+
+// VariadicSpec describes the variadic argument, zero Max means no upper limit.
+type VariadicSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Min         int    `json:"min"`
+	Max         int    `json:"max"`
+}
+
+// Describe returns the model of app, which covers the whole application tree.
+// Lists are never nil, and Variadic is nil when a command does not accept
+// variadic arguments.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+func Describe(app Application) Spec {
+	return model(runnable("cli.Describe", app).tree())
+}
+
+// DescribeJSON returns the model of app (see cli.Describe) as indented JSON,
+// it is identical to what the hidden command of cli.Introspection prints.
+//
+// # Panic when:
+//   - app is nil or not created by this package.
+func DescribeJSON(app Application) ([]byte, error) {
+	return specJSON(runnable("cli.DescribeJSON", app).tree())
+}
+
+func specJSON(root node) ([]byte, error) {
+	data, err := json.MarshalIndent(model(root), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func model(root node) Spec {
+	return Spec{
+		Version: SpecVersion,
+		Command: specify(root),
+	}
+}
+
+// specify returns the model of n and its descendants.
+func specify(n node) CommandSpec {
+	spec := CommandSpec{
+		Name:        n.name(),
+		Path:        n.path,
+		Description: n.description,
+		Usage:       n.synopsis(),
+		Statement:   n.statement,
+		Options:     []OptionSpec{},
+		Flags:       []FlagSpec{},
+		Arguments:   []ArgumentSpec{},
+		Groups:      []GroupSpec{},
+	}
+	for _, option := range n.options {
+		choices := option.Choices()
+		if choices == nil {
+			choices = []string{}
+		}
+		kind := option.Kind()
+		spec.Options = append(spec.Options, OptionSpec{
+			Short:       option.SName(),
+			Long:        option.LName(),
+			Label:       option.Label(),
+			Description: option.Description(),
+			Default:     option.Value(),
+			Choices:     choices,
+			Required:    option.Required(),
+			Env:         option.Env(),
+			Completed:   dynamic(option),
+			Type:        kind.Type,
+			Repeatable:  kind.Repeatable,
+			Min:         kind.Min,
+			Max:         kind.Max,
+			Separator:   kind.Separator,
+			Map:         kind.Map,
+			Optional:    kind.Attached,
+			Implicit:    kind.Implicit,
+		})
+	}
+	for _, flag := range n.flags {
+		spec.Flags = append(spec.Flags, FlagSpec{
+			Short:       flag.SName(),
+			Long:        flag.LName(),
+			Label:       flag.Label(),
+			Description: flag.Description(),
+			Default:     flag.Value() == "true",
+			Negatable:   negatable(flag),
+			Count:       flag.Kind().Count,
+			Env:         flag.Env(),
+		})
+	}
+	for _, argument := range n.arguments {
+		spec.Arguments = append(spec.Arguments, ArgumentSpec{
+			Name:        argument.Name(),
+			Description: argument.Description(),
+			Optional:    argument.Optional(),
+			Default:     argument.Value(),
+			Completed:   argument.Completer() != nil,
+		})
+	}
+	if n.variadic.Allowed() {
+		spec.Variadic = &VariadicSpec{
+			Name:        n.variadic.Name(),
+			Description: n.variadic.Description(),
+			Min:         n.variadic.Min(),
+			Max:         n.variadic.Max(),
+		}
+	}
+	for _, section := range n.sections {
+		group := GroupSpec{
+			Name:     section.name,
+			Commands: []CommandSpec{},
+		}
+		for _, child := range section.nodes {
+			group.Commands = append(group.Commands, specify(child))
+		}
+		spec.Groups = append(spec.Groups, group)
+	}
+	return spec
+}
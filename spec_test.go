@@ -0,0 +1,87 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDescribeJSON(t *testing.T) {
+	data, err := DescribeJSON(fixture())
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "spec.json", string(data))
+}
+
+func TestDescribeKinds(t *testing.T) {
+	nothing := Function(func(Context) error { return nil })
+	app := Simple("tool", "A tool.", Statements(),
+		Options(
+			DurationOption("t", "timeout", "timeout", time.Minute),
+			ListOption("", "tags", "tags", ",", 1, 3),
+			MapOption("", "label", "labels", RejectDuplicateKeys),
+			OptionalOption("", "color", "colorize the output", "WHEN", "always", "auto"),
+		),
+		Flags(CountFlag("v", "verbose", "verbose output"), NegatableFlag("", "cache", "use the cache", true)),
+		Arguments(), NoVariadic(), nothing)
+	spec := Describe(app).Command
+	options := []OptionSpec{
+		{Short: "t", Long: "timeout", Default: "1m0s", Type: "time.Duration"},
+		{Long: "tags", Repeatable: true, Min: 1, Max: 3, Separator: ","},
+		{Long: "label", Repeatable: true, Map: true},
+		{Long: "color", Default: "auto", Optional: true, Implicit: "always"},
+	}
+	for i, want := range options {
+		got := spec.Options[i]
+		got.Label, got.Description, got.Choices = "", "", nil
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("option %d: got %+v, want %+v", i, got, want)
+		}
+	}
+	flags := []FlagSpec{
+		{Short: "v", Long: "verbose", Count: true},
+		{Long: "cache", Default: true, Negatable: true},
+	}
+	for i, want := range flags {
+		got := spec.Flags[i]
+		got.Label, got.Description = "", ""
+		if got != want {
+			t.Errorf("flag %d: got %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func TestIntrospection(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := Execute(Introspection(fixture()), []string{"tool", "__spec"}, &stdout, &stderr)
+	if want, _ := DescribeJSON(fixture()); code != ExitSuccess || stdout.String() != string(want) {
+		t.Errorf("__spec: exit %d, stderr %q", code, stderr.String())
+	}
+}
+
+func TestIntrospectionPanicsOnExistingCommand(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	nothing := Function(func(Context) error { return nil })
+	cmd := Command("__spec", "Describe.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nothing)
+	Introspection(Nested("tool", "A tool.", Statements(), Options(), Flags(), Group("Commands", cmd)))
+}
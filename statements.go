@@ -14,10 +14,6 @@
 
 package cli
 
-import (
-	"strings"
-)
-
 func Statements(statements ...Statement) Statement {
 	//if len(statements) == 0 {
 	//	panic("cli.Statements: statements cannot be empty")
@@ -35,14 +31,26 @@ type _statements struct {
 }
 
 func (s _statements) String(path string) string {
-	if len(s.statements) == 0 {
-		return ""
+	return render(s, path)
+}
+
+func (s _statements) Check(given map[string]bool) error {
+	for _, statement := range s.statements {
+		if err := check(statement, given); err != nil {
+			return err
+		}
 	}
-	var text strings.Builder
+	return nil
+}
+
+func (s _statements) Names() []string {
+	var names []string
 	for _, statement := range s.statements {
-		text.WriteString(statement.String(path))
+		if constraint, ok := statement.(constraint); ok {
+			names = append(names, constraint.Names()...)
+		}
 	}
-	return text.String()
+	return names
 }
 
 func (s _statements) Empty() bool {
@@ -0,0 +1,94 @@
+# bash completion for tool, generated by github.com/begopher/cli
+
+__tool_complete() {
+	local output line directive
+	output="$("${COMP_WORDS[0]}" __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null)" || return
+	directive="${output##*:}"
+	COMPREPLY=()
+	while IFS= read -r line; do
+		[[ -z "$line" || "$line" == :* ]] && continue
+		COMPREPLY+=("${line%%$'\t'*}")
+	done <<<"$output"
+	if ((directive & 1)); then
+		compopt -o nospace
+	fi
+	if ((directive & 2)); then
+		compopt +o default
+	fi
+	if ((${#COMPREPLY[@]} == 0)); then
+		if ((directive & 8)); then
+			COMPREPLY=($(compgen -d -- "$cur"))
+		elif ((directive & 4)); then
+			COMPREPLY=($(compgen -f -- "$cur"))
+		fi
+	fi
+}
+
+_tool() {
+	local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"
+	local cmdpath="tool" word i
+	for ((i = 1; i < COMP_CWORD; i++)); do
+		word="${COMP_WORDS[i]}"
+		case "$cmdpath $word" in
+		"tool deploy" | "tool status" | "tool remote" | "tool remote add" | "tool remote remove") cmdpath="$cmdpath $word" ;;
+		esac
+	done
+	local commands="" switches="" arguments="" dynamic=""
+	case "$cmdpath" in
+	"tool")
+		commands="deploy status remote"
+		switches="-c --config -v --verbose"
+		arguments=""
+		case "$prev" in
+		-c | --config) return ;;
+		esac
+		;;
+	"tool deploy")
+		commands=""
+		switches="-e --env -b --branch --push --no-push"
+		arguments="[SERVICE...]"
+		case "$prev" in
+		-e | --env)
+			COMPREPLY=($(compgen -W "dev prod" -- "$cur"))
+			return
+			;;
+		-b | --branch)
+			__tool_complete
+			return
+			;;
+		esac
+		;;
+	"tool status")
+		commands=""
+		switches="-s --short"
+		arguments=""
+		;;
+	"tool remote")
+		commands="add remove"
+		switches=""
+		arguments=""
+		;;
+	"tool remote add")
+		commands=""
+		switches=""
+		arguments="NAME URL"
+		;;
+	"tool remote remove")
+		commands=""
+		switches=""
+		arguments="NAME"
+		dynamic=1
+		;;
+	esac
+	if [[ "$cur" == -* ]]; then
+		COMPREPLY=($(compgen -W "$switches" -- "$cur"))
+	elif [[ -n "$commands" ]]; then
+		COMPREPLY=($(compgen -W "$commands" -- "$cur"))
+	elif [[ -n "$dynamic" ]]; then
+		__tool_complete
+	elif [[ -z "$arguments" ]]; then
+		compopt +o default
+	fi
+}
+
+complete -o default -F _tool tool
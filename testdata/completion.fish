@@ -0,0 +1,47 @@
+# fish completion for tool, generated by github.com/begopher/cli
+
+function __tool_path
+	set -l cmdpath "tool"
+	for word in (commandline -opc)[2..-1]
+		switch "$cmdpath $word"
+			case "tool deploy" "tool status" "tool remote" "tool remote add" "tool remote remove"
+				set cmdpath "$cmdpath $word"
+		end
+	end
+	echo $cmdpath
+end
+
+function __tool_complete
+	set -l words (commandline -opc) (commandline -ct)
+	set -l output ($words[1] __complete $words[2..-1] 2>/dev/null)
+	test (count $output) -gt 0; or return
+	set -l directive (string replace ':' '' -- $output[-1])
+	set -e output[-1]
+	for line in $output
+		echo $line
+	end
+	if test (count $output) -eq 0
+		if test (math "bitand($directive, 8)") -ne 0
+			__fish_complete_directories (commandline -ct)
+		else if test (math "bitand($directive, 4)") -ne 0 -o (math "bitand($directive, 2)") -eq 0
+			__fish_complete_path (commandline -ct)
+		end
+	end
+end
+
+complete -c tool -f
+complete -c tool -n 'test (__tool_path) = "tool"' -a deploy -d 'Deploy the given services.'
+complete -c tool -n 'test (__tool_path) = "tool"' -a status -d 'Show the status of the services.'
+complete -c tool -n 'test (__tool_path) = "tool"' -a remote -d 'Manage remotes.'
+complete -c tool -n 'test (__tool_path) = "tool"' -s c -l config -r -d 'configuration file'
+complete -c tool -n 'test (__tool_path) = "tool"' -s v -l verbose -d 'verbose output'
+complete -c tool -n 'test (__tool_path) = "tool deploy"' -s e -l env -x -a 'dev prod' -d 'target environment'
+complete -c tool -n 'test (__tool_path) = "tool deploy"' -s b -l branch -x -a '(__tool_complete)' -d 'branch to deploy'
+complete -c tool -n 'test (__tool_path) = "tool deploy"' -l push -d 'push the images'
+complete -c tool -n 'test (__tool_path) = "tool deploy"' -l no-push -d 'push the images'
+complete -c tool -n 'test (__tool_path) = "tool deploy"' -F
+complete -c tool -n 'test (__tool_path) = "tool status"' -s s -l short -d 'short format'
+complete -c tool -n 'test (__tool_path) = "tool remote"' -a add -d 'Add a remote.'
+complete -c tool -n 'test (__tool_path) = "tool remote"' -a remove -d 'Remove a remote.'
+complete -c tool -n 'test (__tool_path) = "tool remote add"' -F
+complete -c tool -n 'test (__tool_path) = "tool remote remove"' -a '(__tool_complete)'
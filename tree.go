@@ -0,0 +1,251 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// node describes a command path of the application tree, it is used to generate
+// completion scripts and documentation.
+type node struct {
+	path        []string
+	description string
+	usage       string
+	statement   string
+	options     []api.Option
+	flags       []api.Flag
+	arguments   []api.Argument
+	variadic    api.Variadic
+	sections    []section
+}
+
+// section is a titled list of sub commands e.g. a cli.Group.
+type section struct {
+	name  string
+	nodes []node
+}
+
+// describe returns the node of cmd, where path is the path of its parent.
+func describe(path []string, cmd api.Command) node {
+	path = append(path[:len(path):len(path)], cmd.Name())
+	full := strings.Join(path, " ")
+	n := node{
+		path:        path,
+		description: cmd.Description(),
+		usage:       cmd.Usage(full),
+		statement:   cmd.Statement(full),
+		options:     cmd.Options().List(),
+		flags:       cmd.Flags().List(),
+		arguments:   cmd.Arguments().List(),
+		variadic:    cmd.Variadic(),
+	}
+	if cmds := cmd.Commands(); len(cmds) > 0 {
+		commands := section{name: "Commands"}
+		for _, cmd := range cmds {
+			commands.nodes = append(commands.nodes, describe(path, cmd))
+		}
+		n.sections = []section{commands}
+	}
+	return n
+}
+
+func (n node) name() string {
+	return n.path[len(n.path)-1]
+}
+
+func (n node) fullPath() string {
+	return strings.Join(n.path, " ")
+}
+
+// children returns the sub commands of all sections.
+func (n node) children() []node {
+	var children []node
+	for _, section := range n.sections {
+		children = append(children, section.nodes...)
+	}
+	return children
+}
+
+// walk visits n and all its descendants in depth-first order.
+func (n node) walk(visit func(node)) {
+	visit(n)
+	for _, child := range n.children() {
+		child.walk(visit)
+	}
+}
+
+// item is an entry of a list of a documentation or man page, where path is the
+// command path of the page it refers to, or nil.
+type item struct {
+	term        string
+	path        []string
+	description string
+	notes       []string
+}
+
+// list is a titled list of a documentation or man page e.g. Options.
+type list struct {
+	title string
+	items []item
+}
+
+// lists returns the sub commands of every section, followed by the options, the
+// flags and the arguments of n, the empty ones are omitted.
+func (n node) lists() []list {
+	var lists []list
+	for _, section := range n.sections {
+		commands := list{title: section.name}
+		for _, child := range section.nodes {
+			commands.items = append(commands.items, item{term: child.name(), path: child.path, description: child.description})
+		}
+		lists = append(lists, commands)
+	}
+	if len(n.options) > 0 {
+		options := list{title: "Options"}
+		for _, option := range n.options {
+			options.items = append(options.items, item{term: spelling(option.SName(), option.Label()), description: option.Description(), notes: optionNotes(option)})
+		}
+		lists = append(lists, options)
+	}
+	if len(n.flags) > 0 {
+		flags := list{title: "Flags"}
+		for _, flag := range n.flags {
+			flags.items = append(flags.items, item{term: spelling(flag.SName(), flag.Label()), description: flag.Description(), notes: flagNotes(flag)})
+		}
+		lists = append(lists, flags)
+	}
+	if len(n.arguments) > 0 || n.variadic.Allowed() {
+		arguments := list{title: "Arguments"}
+		for _, argument := range n.arguments {
+			var notes []string
+			if argument.Optional() && argument.Value() != "" {
+				notes = append(notes, "Default: "+argument.Value())
+			}
+			arguments.items = append(arguments.items, item{term: argument.Name(), description: argument.Description(), notes: notes})
+		}
+		if n.variadic.Allowed() {
+			arguments.items = append(arguments.items, item{term: n.variadic.Name() + "...", description: n.variadic.Description(), notes: variadicNotes(n.variadic.Min(), n.variadic.Max())})
+		}
+		lists = append(lists, arguments)
+	}
+	return lists
+}
+
+// related returns the pages referred to by the page of n, which are its parent (when
+// parent is not nil) and its sub commands.
+func (n node) related(parent []string) []item {
+	var related []item
+	if parent != nil {
+		related = append(related, item{term: strings.Join(parent, " "), path: parent})
+	}
+	for _, child := range n.children() {
+		related = append(related, item{term: child.fullPath(), path: child.path})
+	}
+	return related
+}
+
+// topic is a titled part of a statement text, see node.topics.
+type topic struct {
+	title string
+	lines []string
+}
+
+// topics splits the statement of n into paragraphs separated by empty lines, a
+// paragraph which starts with a line ending with colon e.g. "Examples:" has that
+// line as its title, while all other paragraphs are gathered under Notes.
+func (n node) topics() []topic {
+	var topics []topic
+	notes := -1
+	for _, paragraph := range strings.Split(strings.TrimSpace(n.statement), "\n\n") {
+		lines := strings.Split(strings.Trim(paragraph, "\n"), "\n")
+		if len(lines) == 0 || strings.TrimSpace(lines[0]) == "" {
+			continue
+		}
+		heading := lines[0]
+		if len(lines) > 1 && strings.HasSuffix(heading, ":") && strings.TrimSpace(heading) == heading {
+			topics = append(topics, topic{strings.TrimSuffix(heading, ":"), lines[1:]})
+			continue
+		}
+		if notes < 0 {
+			notes = len(topics)
+			topics = append(topics, topic{title: "Notes"})
+		} else {
+			topics[notes].lines = append(topics[notes].lines, "")
+		}
+		topics[notes].lines = append(topics[notes].lines, lines...)
+	}
+	return topics
+}
+
+// synopsis returns the usage line of n without the Usage prefix.
+func (n node) synopsis() string {
+	line, _, _ := strings.Cut(n.usage, "\n")
+	return strings.TrimSpace(strings.TrimPrefix(line, "Usage: "))
+}
+
+// optionNotes returns the default value, the accepted values, whether it is
+// required and the bound environment variable of option, one per line.
+func optionNotes(option api.Option) []string {
+	var notes []string
+	if value := option.Value(); value != "" {
+		notes = append(notes, "Default: "+value)
+	}
+	if choices := option.Choices(); len(choices) > 0 {
+		notes = append(notes, "One of: "+strings.Join(choices, ", "))
+	}
+	if option.Required() {
+		notes = append(notes, "Required")
+	}
+	if env := option.Env(); env != "" {
+		notes = append(notes, "Environment: $"+env)
+	}
+	return notes
+}
+
+// flagNotes returns the default value and the bound environment variable of flag,
+// one per line.
+func flagNotes(flag api.Flag) []string {
+	var notes []string
+	if value := flag.Value(); value != "" {
+		notes = append(notes, "Default: "+value)
+	}
+	if env := flag.Env(); env != "" {
+		notes = append(notes, "Environment: $"+env)
+	}
+	return notes
+}
+
+// spelling returns the short and long names of an option or a flag as they are
+// written by the end user e.g. -o, --output=VALUE.
+func spelling(sname, label string) string {
+	switch {
+	case sname == "":
+		return label
+	case label == "":
+		return "-" + sname
+	}
+	return "-" + sname + ", " + label
+}
+
+// variadicNotes returns the bounds of variadic values, if any.
+func variadicNotes(min, max int) []string {
+	if bounds := bounds(min, max); bounds != "" {
+		return []string{"Values: " + bounds}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// IntArgument is an Argument which accepts only integers, see cli.Argument.
+//
+// Invalid value given by the end user is reported as a usage error naming the
+// argument before the command is executed, the converted value can be accessed
+// by cli.GetArgument[int](context, "NAME"). Default makes the argument optional.
+func IntArgument(name, description string) typedArgument[int] {
+	return typedArg(name, description, "integer", strconv.Atoi)
+}
+
+// Float64Argument is an Argument which accepts only numbers, see cli.IntArgument.
+func Float64Argument(name, description string) typedArgument[float64] {
+	parse := func(text string) (float64, error) {
+		return strconv.ParseFloat(text, 64)
+	}
+	return typedArg(name, description, "number", parse)
+}
+
+// DurationArgument is an Argument which accepts only durations (e.g. 1h30m, 500ms),
+// see cli.IntArgument.
+func DurationArgument(name, description string) typedArgument[time.Duration] {
+	return typedArg(name, description, "duration (e.g. 1h30m)", time.ParseDuration)
+}
+
+// FileArgument is an Argument which accepts only a path of an existing file (not
+// a directory), see cli.IntArgument. The value is the path as given by the end user.
+func FileArgument(name, description string) typedArgument[string] {
+	parse := func(text string) (string, error) {
+		info, err := os.Stat(text)
+		if err != nil {
+			return "", err
+		}
+		if info.IsDir() {
+			return "", errors.New("directory")
+		}
+		return text, nil
+	}
+	return typedArg(name, description, "existing file", parse)
+}
+
+// DirArgument is an Argument which accepts only a path of an existing directory,
+// see cli.IntArgument. The value is the path as given by the end user.
+func DirArgument(name, description string) typedArgument[string] {
+	parse := func(text string) (string, error) {
+		info, err := os.Stat(text)
+		if err != nil {
+			return "", err
+		}
+		if !info.IsDir() {
+			return "", errors.New("not a directory")
+		}
+		return text, nil
+	}
+	return typedArg(name, description, "existing directory", parse)
+}
+
+// URLArgument is an Argument which accepts only absolute URLs (e.g. https://example.com),
+// see cli.IntArgument. The value is *url.URL.
+func URLArgument(name, description string) typedArgument[*url.URL] {
+	parse := func(text string) (*url.URL, error) {
+		value, err := url.Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		if value.Scheme == "" || value.Host == "" {
+			return nil, errors.New("relative URL")
+		}
+		return value, nil
+	}
+	return typedArg(name, description, "absolute URL (e.g. https://example.com)", parse)
+}
+
+func typedArg[T any](name, description, expected string, parse func(string) (T, error)) typedArgument[T] {
+	return typedArgument[T]{
+		Argument: Argument(name, description),
+		expected: expected,
+		parse:    parse,
+	}
+}
+
+type typedArgument[T any] struct {
+	api.Argument
+	expected string
+	parse    func(string) (T, error)
+}
+
+// Default returns a copy of the argument which can be omitted by the end user, in
+// such case value is converted and used instead, see cli.OptionalArgument.
+//
+//	cli.IntArgument("COUNT", "number of retries").Default("3")
+func (a typedArgument[T]) Default(value string) typedArgument[T] {
+	a.Argument = OptionalArgument(a.Name(), a.Description(), value)
+	return a
+}
+
+func (a typedArgument[T]) Extract(namedArgs map[string]string, values map[string]any, args []string) ([]string, error) {
+	text := a.Value() // used when an optional argument is omitted
+	if len(args) > 0 {
+		text = args[0]
+	} else if text == "" {
+		if a.Optional() {
+			var zero T
+			values[a.Name()] = zero
+		}
+		return a.Argument.Extract(namedArgs, values, args)
+	}
+	value, err := a.parse(text)
+	if err != nil {
+		parse := parseError(InvalidValue, args)
+		parse.Token = text
+		parse.Option = a.Name()
+		parse.Err = errors.New(a.expected + " is expected")
+		var path *fs.PathError
+		if errors.As(err, &path) && !errors.Is(err, fs.ErrNotExist) {
+			parse.Err = err // e.g. permission denied
+		}
+		return args, parse
+	}
+	values[a.Name()] = value
+	return a.Argument.Extract(namedArgs, values, args)
+}
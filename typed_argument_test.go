@@ -0,0 +1,164 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTypedArguments(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	even := func(text string) error {
+		if len(text)%2 != 0 {
+			return errors.New("even length is expected")
+		}
+		return nil
+	}
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(), Flags(Flag("v", "verbose", "verbose output")),
+			Arguments(IntArgument("COUNT", "count"), DurationArgument("WAIT", "wait"), URLArgument("URL", "url"),
+				FileArgument("FILE", "file"), DirArgument("DIR", "dir"), ValidatedArgument(Argument("CODE", "code"), even)),
+			NoVariadic(), implementation)
+	}
+	valid := []string{"3", "1m30s", "https://example.com/x", file, dir, "ab"}
+	with := func(index int, value string) []string {
+		args := append([]string{"tool", "-v"}, valid...)
+		args[index+2] = value
+		return args
+	}
+	tests := []struct {
+		args     []string
+		option   string
+		position int
+	}{
+		{append([]string{"tool", "-v"}, valid...), "", 0},
+		{with(0, "three"), "COUNT", 2},
+		{with(1, "90"), "WAIT", 3},
+		{with(2, "example.com"), "URL", 4},
+		{with(3, dir), "FILE", 5},
+		{with(3, filepath.Join(dir, "missing")), "FILE", 5},
+		{with(4, file), "DIR", 6},
+		{with(5, "abc"), "CODE", 7},
+		{[]string{"tool", "x", "-v", "1m", "https://a.b", file, dir, "ab"}, "COUNT", 1},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if test.option == "" {
+			if failed(t, test.args, parse, Unspecified, "", 0) {
+				continue
+			}
+			if count := GetArgument[int](ctx, "COUNT"); count != 3 {
+				t.Errorf("%v: COUNT is %d, want 3", test.args, count)
+			}
+			if wait := GetArgument[time.Duration](ctx, "WAIT"); wait != 90*time.Second {
+				t.Errorf("%v: WAIT is %s, want 1m30s", test.args, wait)
+			}
+			if link := GetArgument[*url.URL](ctx, "URL"); link.Host != "example.com" {
+				t.Errorf("%v: URL host is %q, want example.com", test.args, link.Host)
+			}
+			if code := ctx.Argument("CODE"); code != "ab" {
+				t.Errorf("%v: CODE is %q, want ab", test.args, code)
+			}
+			continue
+		}
+		token := test.args[test.position]
+		if failed(t, test.args, parse, InvalidValue, token, test.position) {
+			if parse != nil && parse.Option != test.option {
+				t.Errorf("%v: error is attributed to %q, want %q", test.args, parse.Option, test.option)
+			}
+		}
+	}
+}
+
+func TestOptionalTypedArguments(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(), Flags(),
+			Arguments(IntArgument("COUNT", "count"), DurationArgument("WAIT", "wait").Default("1m"),
+				IntArgument("RETRIES", "retries").Default("")),
+			NoVariadic(), implementation)
+	}
+	tests := []struct {
+		args    []string
+		wait    time.Duration
+		retries int
+	}{
+		{[]string{"tool", "3"}, time.Minute, 0},
+		{[]string{"tool", "3", "5s"}, 5 * time.Second, 0},
+		{[]string{"tool", "3", "5s", "2"}, 5 * time.Second, 2},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, Unspecified, "", 0) {
+			continue
+		}
+		if wait := GetArgument[time.Duration](ctx, "WAIT"); wait != test.wait {
+			t.Errorf("%v: WAIT is %s, want %s", test.args, wait, test.wait)
+		}
+		if retries := GetArgument[int](ctx, "RETRIES"); retries != test.retries {
+			t.Errorf("%v: RETRIES is %d, want %d", test.args, retries, test.retries)
+		}
+	}
+	args := []string{"tool", "3", "soon"}
+	if _, parse := invoke(t, app, nil, args...); failed(t, args, parse, InvalidValue, "soon", 2) && parse != nil && parse.Option != "WAIT" {
+		t.Errorf("%v: error is attributed to %q, want WAIT", args, parse.Option)
+	}
+	var help HelpRequested
+	if err := app(Function(func(Context) error { return nil })).Run([]string{"tool", "--help"}); !errors.As(err, &help) {
+		t.Fatalf("got %v, want HelpRequested", err)
+	}
+	for _, text := range []string{"COUNT [WAIT] [RETRIES]", "wait (1m)"} {
+		if !strings.Contains(help.Usage, text) {
+			t.Errorf("usage does not contain %q:\n%s", text, help.Usage)
+		}
+	}
+}
+
+func TestFileArgumentKeepsStatError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(), Flags(),
+			Arguments(FileArgument("FILE", "file")), NoVariadic(), implementation)
+	}
+	tests := []struct {
+		path string
+		text string
+	}{
+		{filepath.Join(file, "missing"), "not a directory"},
+		{file + "-missing", "existing file is expected"},
+	}
+	for _, test := range tests {
+		args := []string{"tool", test.path}
+		_, parse := invoke(t, app, nil, args...)
+		if failed(t, args, parse, InvalidValue, test.path, 1) || parse == nil {
+			continue
+		}
+		if !strings.Contains(parse.Err.Error(), test.text) {
+			t.Errorf("%v: error is %q, want it to contain %q", args, parse.Err, test.text)
+		}
+	}
+}
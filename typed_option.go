@@ -0,0 +1,146 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// IntOption is an Option which accepts only integers, see cli.Option.
+//
+// Invalid value given by the end user is reported as a usage error before
+// the command is executed, the converted value can be accessed by
+// cli.Get[int](context, "key").
+func IntOption(sname, lname, description string, value int) typedOption[int] {
+	return typed(sname, lname, description, value, "integer", strconv.Atoi, strconv.Itoa)
+}
+
+// Int64Option is an Option which accepts only 64-bit integers, see cli.IntOption.
+func Int64Option(sname, lname, description string, value int64) typedOption[int64] {
+	parse := func(text string) (int64, error) {
+		return strconv.ParseInt(text, 10, 64)
+	}
+	format := func(value int64) string {
+		return strconv.FormatInt(value, 10)
+	}
+	return typed(sname, lname, description, value, "integer", parse, format)
+}
+
+// UintOption is an Option which accepts only non-negative integers, see cli.IntOption.
+func UintOption(sname, lname, description string, value uint) typedOption[uint] {
+	parse := func(text string) (uint, error) {
+		value, err := strconv.ParseUint(text, 10, strconv.IntSize)
+		return uint(value), err
+	}
+	format := func(value uint) string {
+		return strconv.FormatUint(uint64(value), 10)
+	}
+	return typed(sname, lname, description, value, "non-negative integer", parse, format)
+}
+
+// Float64Option is an Option which accepts only numbers, see cli.IntOption.
+func Float64Option(sname, lname, description string, value float64) typedOption[float64] {
+	parse := func(text string) (float64, error) {
+		return strconv.ParseFloat(text, 64)
+	}
+	format := func(value float64) string {
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+	return typed(sname, lname, description, value, "number", parse, format)
+}
+
+// BoolOption is an Option which accepts only booleans (true, false, 1, 0, t, f),
+// see cli.IntOption. Unlike cli.Flag the value must be given e.g. --cache=false.
+func BoolOption(sname, lname, description string, value bool) typedOption[bool] {
+	return typed(sname, lname, description, value, "boolean", strconv.ParseBool, strconv.FormatBool)
+}
+
+// DurationOption is an Option which accepts only durations (e.g. 1h30m, 500ms),
+// see cli.IntOption.
+func DurationOption(sname, lname, description string, value time.Duration) typedOption[time.Duration] {
+	format := func(value time.Duration) string {
+		return value.String()
+	}
+	return typed(sname, lname, description, value, "duration (e.g. 1h30m)", time.ParseDuration, format)
+}
+
+// TimeOption is an Option which accepts only time formatted according to
+// layout (e.g. time.DateOnly), see cli.IntOption. Zero value of time is
+// not printed as a default value.
+//
+// # Panic when:
+//   - layout is empty.
+func TimeOption(sname, lname, description, layout string, value time.Time) typedOption[time.Time] {
+	if layout == "" {
+		panic("cli.TimeOption: layout cannot be empty")
+	}
+	parse := func(text string) (time.Time, error) {
+		return time.Parse(layout, text)
+	}
+	format := func(value time.Time) string {
+		if value.IsZero() {
+			return ""
+		}
+		return value.Format(layout)
+	}
+	expected := fmt.Sprintf("time (e.g. %s)", layout)
+	return typed(sname, lname, description, value, expected, parse, format)
+}
+
+func typed[T any](sname, lname, description string, value T, expected string, parse func(string) (T, error), format func(T) string) typedOption[T] {
+	return typedOption[T]{
+		option:   Option(sname, lname, description, format(value)),
+		value:    value,
+		expected: expected,
+		parse:    parse,
+	}
+}
+
+type typedOption[T any] struct {
+	option
+	value    T
+	expected string
+	parse    func(string) (T, error)
+}
+
+func (o typedOption[T]) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	name, text, rest, ok := o.match(args)
+	if !ok {
+		return args, nil
+	}
+	value, err := o.parse(text)
+	if err != nil {
+		return args, valueError(InvalidValue, args, rest, name, text, errors.New(o.expected+" is expected"))
+	}
+	o.set(opts, text)
+	o.store(values, value)
+	return rest, nil
+}
+
+func (o typedOption[T]) Kind() api.Kind {
+	return api.Kind{Type: fmt.Sprintf("%T", o.value)}
+}
+
+func (o typedOption[T]) Default(opts map[string]string, values map[string]any) {
+	o.option.Default(opts, values)
+	if _, ok := values[o.key()]; !ok {
+		o.store(values, o.value)
+	}
+}
@@ -0,0 +1,80 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+)
+
+// UsageError is returned by Application.Run when the end user invoked the
+// application incorrectly, e.g. unknown option or missing argument. The
+// usage message should be printed to the standard error and the application
+// should exit with status 2.
+//
+// Error() returns the rendered usage message, which ends with a summary of
+// the mistake, therefore printing the error as is remains a valid option.
+type UsageError struct {
+	// Path is the path of the command which rejected the given input.
+	Path []string
+	// Token is the offending argv item, it is empty when the mistake is
+	// about something missing e.g. a command or an argument.
+	Token string
+	Kind  ErrorKind
+	// Err describes the mistake without the usage message, it is *cli.ParseError
+	// when the mistake is detected while parsing the input.
+	Err error
+	// Usage is the rendered usage message including the summary of the mistake.
+	Usage string
+}
+
+func (u *UsageError) Error() string {
+	return u.Usage
+}
+
+func (u *UsageError) Unwrap() error {
+	return u.Err
+}
+
+func usageError(path []string, kind ErrorKind, token string, err error, usage string) *UsageError {
+	return &UsageError{
+		Path:  clonePath(path),
+		Token: token,
+		Kind:  kind,
+		Err:   err,
+		Usage: usage,
+	}
+}
+
+// parseFailure wraps err by UsageError, where usage renders the usage message
+// of the command which rejected the input, with the given summaries.
+func parseFailure(path []string, err *ParseError, usage func(...string) string) *UsageError {
+	err.Path = clonePath(path)
+	return usageError(path, err.Kind, err.Token, err, usage(summary(err)))
+}
+
+func clonePath(path []string) []string {
+	clone := make([]string, len(path))
+	copy(clone, path)
+	return clone
+}
+
+// failure converts err returned by options, arguments or variadic to UsageError.
+func failure(path []string, err error, usage func(...string) string) error {
+	var parse *ParseError
+	if errors.As(err, &parse) {
+		return parseFailure(path, parse, usage)
+	}
+	return usageError(path, Unspecified, "", err, usage(summary(err)))
+}
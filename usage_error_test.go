@@ -0,0 +1,78 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// hello returns an application with a single command (greet).
+func hello() Application {
+	nothing := Function(func(Context) error { return nil })
+	greet := Command("greet", "Greet the world.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nothing)
+	return Nested("tool", "A tool.", Statements(), Options(), Flags(), Group("Main", greet))
+}
+
+func TestHelpRequested(t *testing.T) {
+	for _, args := range [][]string{{"tool", "--help"}, {"tool", "greet", "--help"}} {
+		err := hello().Run(args)
+		var help HelpRequested
+		if !errors.As(err, &help) {
+			t.Errorf("%q: got %v, want HelpRequested", args, err)
+			continue
+		}
+		if strings.Join(help.Path, " ") != strings.Join(args[:len(args)-1], " ") || !strings.HasPrefix(help.Usage, "Usage: ") {
+			t.Errorf("%q: unexpected %q of %q", args, help.Usage, help.Path)
+		}
+	}
+}
+
+func TestUsageError(t *testing.T) {
+	tests := []struct {
+		args    []string
+		path    []string
+		summary string
+	}{
+		{nil, []string{"tool"}, "Error: application name is missing"},
+		{[]string{"other"}, []string{"tool"}, "Error: unexpected application name (other)"},
+		{[]string{"tool"}, []string{"tool"}, "Error: "},
+		{[]string{"tool", "greet", "--loud"}, []string{"tool", "greet"}, "Error: "},
+	}
+	for _, test := range tests {
+		err := hello().Run(test.args)
+		var usage *UsageError
+		if !errors.As(err, &usage) {
+			t.Errorf("%q: got %v, want *UsageError", test.args, err)
+			continue
+		}
+		if strings.Join(usage.Path, " ") != strings.Join(test.path, " ") {
+			t.Errorf("%q: got path %q, want %q", test.args, usage.Path, test.path)
+		}
+		if !strings.Contains(usage.Usage, test.summary) || usage.Error() != usage.Usage {
+			t.Errorf("%q: usage does not contain %q:\n%s", test.args, test.summary, usage.Usage)
+		}
+	}
+}
+
+func TestUsageErrorOfSimpleApplication(t *testing.T) {
+	nothing := Function(func(Context) error { return nil })
+	app := Simple("tool", "A tool.", Statements(), Options(), Flags(), Arguments(), NoVariadic(), nothing)
+	var usage *UsageError
+	if err := app.Run(nil); !errors.As(err, &usage) || !strings.Contains(usage.Usage, "Error: application name is missing") {
+		t.Errorf("got %v, want usage error with summary", err)
+	}
+}
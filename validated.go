@@ -0,0 +1,73 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+// Validated attaches validate function to the given option, which is invoked with
+// every value given by the end user. The returned error (if any) is reported as a
+// usage error attributed to the option, before the command is executed.
+//
+//	cli.Validated(cli.Option("", "name", "bucket name", ""), func(value string) error {
+//		if strings.ToLower(value) != value {
+//			return errors.New("lower case is expected")
+//		}
+//		return nil
+//	})
+//
+// # Panic when:
+//   - option is nil.
+//   - validate is nil.
+func Validated(option api.Option, validate func(string) error) validated {
+	if option == nil {
+		panic("cli.Validated: option cannot be nil")
+	}
+	if validate == nil {
+		panic("cli.Validated: validate cannot be nil")
+	}
+	return validated{
+		Option:   option,
+		validate: validate,
+	}
+}
+
+type validated struct {
+	api.Option
+	validate func(string) error
+}
+
+func (v validated) Extract(opts map[string]string, values map[string]any, args []string) ([]string, error) {
+	rest, err := v.Option.Extract(opts, values, args)
+	if err != nil || len(rest) == len(args) {
+		return rest, err
+	}
+	key := v.LName()
+	if key == "" {
+		key = v.SName()
+	}
+	value := opts[key]
+	if err := v.validate(value); err != nil {
+		name := "-" + v.SName()
+		if strings.HasPrefix(args[0], "--") {
+			name = "--" + v.LName()
+		}
+		return args, valueError(InvalidValue, args, rest, name, value, err)
+	}
+	return rest, nil
+}
@@ -0,0 +1,66 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"github.com/begopher/cli/internal/api"
+)
+
+// ValidatedArgument attaches validate function to the given argument, which is invoked
+// with the value given by the end user (default values of optional arguments are not
+// validated). The returned error (if any) is reported as a usage error naming the
+// argument, before the command is executed.
+//
+//	cli.ValidatedArgument(cli.Argument("BRANCH", "branch name"), func(value string) error {
+//		if strings.HasPrefix(value, "-") {
+//			return errors.New("branch name cannot start with -")
+//		}
+//		return nil
+//	})
+//
+// # Panic when:
+//   - argument is nil.
+//   - validate is nil.
+func ValidatedArgument(argument api.Argument, validate func(string) error) validatedArgument {
+	if argument == nil {
+		panic("cli.ValidatedArgument: argument cannot be nil")
+	}
+	if validate == nil {
+		panic("cli.ValidatedArgument: validate cannot be nil")
+	}
+	return validatedArgument{
+		Argument: argument,
+		validate: validate,
+	}
+}
+
+type validatedArgument struct {
+	api.Argument
+	validate func(string) error
+}
+
+func (v validatedArgument) Extract(namedArgs map[string]string, values map[string]any, args []string) ([]string, error) {
+	rest, err := v.Argument.Extract(namedArgs, values, args)
+	if err != nil || len(rest) == len(args) {
+		return rest, err
+	}
+	if err := v.validate(args[0]); err != nil {
+		parse := parseError(InvalidValue, args)
+		parse.Option = v.Name()
+		parse.Err = err
+		return args, parse
+	}
+	return rest, nil
+}
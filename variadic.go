@@ -44,13 +44,56 @@ func Variadic(arg, description string) variadic {
 	}
 }
 
+// VariadicRange is a Variadic which accepts at least min and at most max values, zero
+// max means no upper limit. It is printed as FILE... in the usage line when at least
+// one value is required, otherwise as [FILE...] like cli.Variadic. Violating min or max is reported as
+// a usage error naming the variadic argument.
+//
+// # Panic when:
+//   - min or max is negative.
+//   - max is less than min (unless max is zero).
+//   - see cli.Variadic.
+func VariadicRange(arg, description string, min, max int) variadic {
+	if min < 0 || max < 0 {
+		panic("cli.VariadicRange: min and max cannot be negative")
+	}
+	if max != 0 && max < min {
+		panic("cli.VariadicRange: max cannot be less than min")
+	}
+	variadic := Variadic(arg, description)
+	variadic.min = min
+	variadic.max = max
+	return variadic
+}
+
 type variadic struct {
 	arg         string
 	description string
+	min         int
+	max         int
 }
 
 func (v variadic) Arg() string {
-	return fmt.Sprintf("[%s]", v.arg)
+	if v.min == 0 {
+		return fmt.Sprintf("[%s...]", v.arg)
+	}
+	return v.arg + "..."
+}
+
+func (v variadic) Name() string {
+	return v.arg
+}
+
+func (v variadic) Description() string {
+	return v.description
+}
+
+func (v variadic) Min() int {
+	return v.min
+}
+
+func (v variadic) Max() int {
+	return v.max
 }
 
 func (v variadic) Allowed() bool {
@@ -58,6 +101,19 @@ func (v variadic) Allowed() bool {
 }
 
 func (v variadic) Extract(args []string) ([]string, error) {
+	if len(args) < v.min {
+		err := parseError(TooFewValues, nil)
+		err.Option = v.arg
+		err.Err = fmt.Errorf("at least %d must be given", v.min)
+		return args, err
+	}
+	if v.max != 0 && len(args) > v.max {
+		err := parseError(TooManyValues, args[v.max:])
+		err.Option = v.arg
+		err.Values = args[v.max:]
+		err.Err = fmt.Errorf("at most %d can be given", v.max)
+		return args, err
+	}
 	return args, nil
 }
 
@@ -68,7 +124,28 @@ func (v variadic) String() string {
 	var text strings.Builder
 	text.WriteString("\n")
 	text.WriteString("Variadic:\n")
-	msg := fmt.Sprintf("  [%s]  %s\n", v.arg, v.description)
+	msg := fmt.Sprintf("  %s  %s%s\n", v.Arg(), v.description, v.arity())
 	text.WriteString(msg)
 	return text.String()
 }
+
+// arity returns the bounds of values (if any) as a note of the Variadic section.
+func (v variadic) arity() string {
+	if bounds := bounds(v.min, v.max); bounds != "" {
+		return fmt.Sprintf(" (%s)", bounds)
+	}
+	return ""
+}
+
+// bounds returns the limits of the number of variadic values e.g. "at least 1, at
+// most 3", or empty string when there is no limit.
+func bounds(min, max int) string {
+	var bounds []string
+	if min > 0 {
+		bounds = append(bounds, fmt.Sprintf("at least %d", min))
+	}
+	if max > 0 {
+		bounds = append(bounds, fmt.Sprintf("at most %d", max))
+	}
+	return strings.Join(bounds, ", ")
+}
@@ -0,0 +1,93 @@
+//   Copyright 2023 Abdulrahman Abdulhamid Alsaedi
+//
+//   Licensed under the Apache License, Version 2.0 (the "License");
+//   you may not use this file except in compliance with the License.
+//   You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//   Unless required by applicable law or agreed to in writing, software
+//   distributed under the License is distributed on an "AS IS" BASIS,
+//   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//   See the License for the specific language governing permissions and
+//   limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/begopher/cli/internal/api"
+)
+
+func TestVariadicRange(t *testing.T) {
+	app := func(implementation Implementation) Application {
+		return Simple("tool", "a tool", Statements(), Options(), Flags(Flag("v", "verbose", "verbose output")),
+			Arguments(Argument("DIR", "target directory")), VariadicRange("FILE", "files to copy", 1, 3), implementation)
+	}
+	tests := []struct {
+		args     []string
+		kind     ErrorKind
+		token    string
+		position int
+	}{
+		{[]string{"tool", "dir", "a"}, Unspecified, "", 0},
+		{[]string{"tool", "-v", "dir", "a", "b", "c"}, Unspecified, "", 0},
+		{[]string{"tool", "dir"}, TooFewValues, "", 2},
+		{[]string{"tool", "dir", "a", "b", "c", "d", "e"}, TooManyValues, "d", 5},
+	}
+	for _, test := range tests {
+		ctx, parse := invoke(t, app, nil, test.args...)
+		if failed(t, test.args, parse, test.kind, test.token, test.position) {
+			if parse != nil && parse.Option != "FILE" {
+				t.Errorf("%v: error is attributed to %q, want FILE", test.args, parse.Option)
+			}
+			continue
+		}
+		if files := ctx.Variadic(); strings.Join(files, " ") != strings.Join(test.args[len(test.args)-len(files):], " ") || len(files) == 0 {
+			t.Errorf("%v: unexpected variadic values %q", test.args, files)
+		}
+	}
+}
+
+func TestVariadicUsage(t *testing.T) {
+	tests := []struct {
+		variadic api.Variadic
+		usage    string
+		section  string
+	}{
+		{Variadic("FILE", "files"), "[FILE...]", "  [FILE...]  files\n"},
+		{VariadicRange("FILE", "files", 0, 0), "[FILE...]", "  [FILE...]  files\n"},
+		{VariadicRange("FILE", "files", 0, 2), "[FILE...]", "  [FILE...]  files (at most 2)\n"},
+		{VariadicRange("FILE", "files", 2, 0), "FILE...", "  FILE...  files (at least 2)\n"},
+		{VariadicRange("FILE", "files", 1, 3), "FILE...", "  FILE...  files (at least 1, at most 3)\n"},
+	}
+	nothing := Function(func(Context) error { return nil })
+	for _, test := range tests {
+		app := Simple("tool", "a tool", Statements(), Options(), Flags(), Arguments(), test.variadic, nothing)
+		var help HelpRequested
+		if err := app.Run([]string{"tool", "--help"}); !errors.As(err, &help) {
+			t.Errorf("%s: got %v, want HelpRequested", test.usage, err)
+			continue
+		}
+		if !strings.Contains(help.Usage, "[--] "+test.usage) || !strings.Contains(help.Usage, test.section) {
+			t.Errorf("%s: unexpected usage:\n%s", test.usage, help.Usage)
+		}
+	}
+}
+
+func TestVariadicRangePanics(t *testing.T) {
+	tests := []struct{ min, max int }{{-1, 0}, {0, -1}, {3, 2}}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("VariadicRange(%d, %d) does not panic", test.min, test.max)
+				}
+			}()
+			VariadicRange("FILE", "files", test.min, test.max)
+		}()
+	}
+}